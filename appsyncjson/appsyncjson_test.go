@@ -0,0 +1,438 @@
+package appsyncjson_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	nestedv1 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/nested/v1"
+	simplev1 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/simple/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestAppsyncjson(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "appsyncjson")
+}
+
+var _ = Describe("oneof", func() {
+	DescribeTable("marshal", func(msg proto.Message, exp string) {
+		data, err := appsyncjson.Marshal(msg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(exp))
+	},
+		Entry("unset", &simplev1.EchoResponse{Message: "foo"},
+			`{"message":"foo","decorationCase":null}`),
+		Entry("string member", &simplev1.EchoResponse{Decoration: &simplev1.EchoResponse_Prefix{Prefix: "bar"}},
+			`{"message":"","decorationCase":"PREFIX","prefix":"bar"}`),
+		Entry("zero value member", &simplev1.EchoResponse{Decoration: &simplev1.EchoResponse_Repeat{}},
+			`{"message":"","decorationCase":"REPEAT","repeat":0}`),
+	)
+
+	DescribeTable("unmarshal", func(data string, exp proto.Message, expErr string) {
+		var msg simplev1.EchoRequest
+		err := appsyncjson.Unmarshal([]byte(data), &msg)
+		if expErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+			return
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proto.Equal(&msg, exp)).To(BeTrue())
+	},
+		Entry("no oneof", `{"message":"foo"}`, &simplev1.EchoRequest{Message: "foo"}, ""),
+		Entry("null oneof", `{"message":"foo","decoration":null}`, &simplev1.EchoRequest{Message: "foo"}, ""),
+		Entry("one member", `{"decoration":{"repeat":2}}`,
+			&simplev1.EchoRequest{Decoration: &simplev1.EchoRequest_Repeat{Repeat: 2}}, ""),
+		Entry("one member with nulls", `{"decoration":{"prefix":null,"repeat":2}}`,
+			&simplev1.EchoRequest{Decoration: &simplev1.EchoRequest_Repeat{Repeat: 2}}, ""),
+		Entry("multiple members", `{"decoration":{"prefix":"foo","repeat":2}}`,
+			nil, "oneof 'decoration' must have at most one member set, got: [prefix repeat]"),
+	)
+})
+
+var _ = Describe("maps", func() {
+	It("should marshal maps as lists of entries", func() {
+		data, err := appsyncjson.Marshal(&simplev1.MapKinds{
+			StringKeys: map[string]string{"b": "2", "a": "1"},
+			Int32Keys:  map[int32]*simplev1.ScalarKinds{-1: {BoolValue: true}},
+			Int64Keys:  map[int64]string{10: "foo"},
+			BoolKeys:   map[bool]string{true: "yes"},
+			JsonObject: map[string]string{"foo": "bar"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		var out map[string]any
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out["stringKeys"]).To(Equal([]any{
+			map[string]any{"key": "a", "value": "1"},
+			map[string]any{"key": "b", "value": "2"},
+		}))
+		Expect(out["int32Keys"]).To(ConsistOf(And(
+			HaveKeyWithValue("key", -1.0),
+			HaveKeyWithValue("value", HaveKeyWithValue("boolValue", true)),
+		)))
+		Expect(out["int64Keys"]).To(Equal([]any{map[string]any{"key": "10", "value": "foo"}}))
+		Expect(out["boolKeys"]).To(Equal([]any{map[string]any{"key": true, "value": "yes"}}))
+		Expect(out["jsonObject"]).To(Equal(map[string]any{"foo": "bar"}))
+	})
+
+	It("should marshal empty maps as empty lists", func() {
+		data, err := appsyncjson.Marshal(&simplev1.MapKinds{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"stringKeys":[],"int32Keys":[],"int64Keys":[],"boolKeys":[],"jsonObject":{}}`))
+	})
+
+	It("should unmarshal lists of entries into maps", func() {
+		var msg simplev1.MapKinds
+		Expect(appsyncjson.Unmarshal([]byte(`{
+			"stringKeys": [{"key": "a", "value": "1"}],
+			"int32Keys": [{"key": -1, "value": {"boolValue": true}}],
+			"int64Keys": [{"key": "10", "value": "foo"}],
+			"boolKeys": [{"key": false, "value": "no"}],
+			"jsonObject": {"foo": "bar"}
+		}`), &msg)).To(Succeed())
+
+		Expect(proto.Equal(&msg, &simplev1.MapKinds{
+			StringKeys: map[string]string{"a": "1"},
+			Int32Keys:  map[int32]*simplev1.ScalarKinds{-1: {BoolValue: true}},
+			Int64Keys:  map[int64]string{10: "foo"},
+			BoolKeys:   map[bool]string{false: "no"},
+			JsonObject: map[string]string{"foo": "bar"},
+		})).To(BeTrue())
+	})
+
+	It("should reject unsupported keys", func() {
+		var msg simplev1.MapKinds
+		Expect(appsyncjson.Unmarshal([]byte(`{"stringKeys": [{"key": {}, "value": "1"}]}`), &msg)).To(
+			MatchError(ContainSubstring("invalid key for map 'string_keys'")))
+	})
+})
+
+var _ = Describe("scalars", func() {
+	It("should marshal int64 timestamps as json numbers", func() {
+		data, err := appsyncjson.Marshal(&simplev1.AWSKinds{Id: "foo", Timestamp: 1673274081})
+		Expect(err).ToNot(HaveOccurred())
+
+		var out map[string]any
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out).To(HaveKeyWithValue("id", "foo"))
+		Expect(out).To(HaveKeyWithValue("timestamp", 1673274081.0))
+	})
+
+	DescribeTable("validate", func(field, value, expErr string) {
+		var msg simplev1.AWSKinds
+		data, _ := json.Marshal(map[string]any{field: value})
+
+		Expect(appsyncjson.Unmarshal(data, &msg)).To(Succeed()) // no validation by default
+		err := appsyncjson.UnmarshalOptions{ValidateScalars: true}.Unmarshal(data, &msg)
+		if expErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+		}
+	},
+		Entry("id", "id", "anything", ""),
+		Entry("email", "email", "foo@example.com", ""),
+		Entry("invalid email", "email", "foo", "invalid AWSEmail value for field 'email'"),
+		Entry("email with name", "email", "Foo <foo@example.com>", "invalid AWSEmail value for field 'email'"),
+		Entry("url", "url", "mailto:foo@example.com", ""),
+		Entry("invalid url", "url", "example.com", "invalid AWSURL value for field 'url'"),
+		Entry("phone", "phone", "+1 (555) 123-4567", ""),
+		Entry("invalid phone", "phone", "call me", "invalid AWSPhone value for field 'phone'"),
+		Entry("ipv4", "ipAddress", "127.0.0.1", ""),
+		Entry("ipv6 with prefix", "ipAddress", "2001:db8::/32", ""),
+		Entry("invalid ip", "ipAddress", "localhost", "invalid AWSIPAddress value for field 'ip_address'"),
+		Entry("date", "date", "1970-01-01", ""),
+		Entry("date with offset", "date", "1970-01-01+01:00", ""),
+		Entry("invalid date", "date", "1970-13-01", "invalid AWSDate value for field 'date'"),
+		Entry("time", "time", "12:30:00.123Z", ""),
+		Entry("invalid time", "time", "12", "invalid AWSTime value for field 'time'"),
+		Entry("date time", "dateTime", "1970-01-01T12:30:00.123+01:00", ""),
+		Entry("invalid date time", "dateTime", "1970-01-01", "invalid AWSDateTime value for field 'date_time'"),
+	)
+})
+
+var _ = Describe("enums", func() {
+	It("should marshal omitted zero values as null", func() {
+		data, err := appsyncjson.Marshal(&simplev1.EnumKinds{
+			Forecast: []simplev1.Weather{simplev1.Weather_WEATHER_UNSPECIFIED, simplev1.Weather_WEATHER_SUNNY},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"mood":"MOOD_UNSPECIFIED","level":"LEVEL_UNSPECIFIED",` +
+			`"weather":null,"forecast":[null,"WEATHER_SUNNY"]}`))
+	})
+
+	It("should marshal proto2 enums without a zero value to omit", func() {
+		var fdp descriptorpb.FileDescriptorProto
+		Expect(prototext.Unmarshal([]byte(`
+			name: "test/v1/enum.proto" package: "test.v1" syntax: "proto2"
+			dependency: "appsync/v1/appsync.proto"
+			enum_type {
+				name: "Foo"
+				value { name: "FOO_BAR" number: 1 }
+				options { [appsync.v1.enum] { omit_zero: true } }
+			}
+			message_type {
+				name: "Bar"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_ENUM type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}`), &fdp)).To(Succeed())
+
+		fd, err := protodesc.NewFile(&fdp, protoregistry.GlobalFiles)
+		Expect(err).ToNot(HaveOccurred())
+
+		msg := dynamicpb.NewMessage(fd.Messages().ByName("Bar"))
+		msg.Set(msg.Descriptor().Fields().ByName("foo"), protoreflect.ValueOfEnum(1))
+		data, err := appsyncjson.Marshal(msg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"foo":"FOO_BAR"}`))
+	})
+
+	It("should unmarshal omitted and null values as the zero value", func() {
+		var msg simplev1.EnumKinds
+		Expect(appsyncjson.Unmarshal([]byte(`{"mood":"MOOD_JOYFUL","weather":null}`), &msg)).To(Succeed())
+		Expect(proto.Equal(&msg, &simplev1.EnumKinds{Mood: simplev1.Mood_MOOD_HAPPY})).To(BeTrue())
+	})
+})
+
+var _ = Describe("field names", func() {
+	It("should marshal and unmarshal fields with their name option", func() {
+		data, err := appsyncjson.Marshal(&simplev1.ListProfilesResponse{TotalProfiles: 2})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"profileIds":[],"count":2}`))
+
+		var msg simplev1.ListProfilesResponse
+		Expect(appsyncjson.Unmarshal([]byte(`{"count":3}`), &msg)).To(Succeed())
+		Expect(msg.TotalProfiles).To(Equal(int32(3)))
+	})
+
+	It("should marshal and unmarshal proto names", func() {
+		data, err := appsyncjson.MarshalOptions{UseProtoNames: true}.Marshal(&simplev1.EchoResponse{
+			Decoration: &simplev1.EchoResponse_Prefix{Prefix: "foo"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"message":"","decoration_case":"PREFIX","prefix":"foo"}`))
+
+		var msg simplev1.EchoKindsRequest
+		Expect(appsyncjson.UnmarshalOptions{UseProtoNames: true}.Unmarshal(
+			[]byte(`{"aws":{"ip_address":"127.0.0.1","date_time":"1970-01-01T00:00:00Z"}}`), &msg)).To(Succeed())
+		Expect(msg.Aws.IpAddress).To(Equal("127.0.0.1"))
+		Expect(msg.Aws.DateTime).To(Equal("1970-01-01T00:00:00Z"))
+	})
+})
+
+var _ = Describe("empty messages", func() {
+	It("should marshal empty messages as true when configured", func() {
+		data, err := appsyncjson.MarshalOptions{EmptyAsBoolean: true}.Marshal(&simplev1.EchoKindsResponse{
+			Ack: &simplev1.Acknowledgement{},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"kinds":null,"wellKnown":null,"maps":null,"aws":null,"enums":null,"ack":true}`))
+
+		data, err = appsyncjson.MarshalOptions{EmptyAsBoolean: true}.Marshal(&simplev1.EchoKindsResponse{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(ContainSubstring(`"ack":null`))
+
+		data, err = appsyncjson.MarshalOptions{EmptyAsBoolean: true}.Marshal(&simplev1.VersionRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`true`))
+	})
+
+	DescribeTable("unmarshal arguments of empty messages", func(data string) {
+		var msg simplev1.VersionRequest
+		Expect(appsyncjson.Unmarshal([]byte(data), &msg)).To(Succeed())
+	},
+		Entry("object", `{}`),
+		Entry("null", `null`),
+		Entry("no data", ``),
+	)
+})
+
+var _ = Describe("connections", func() {
+	postDesc := (&nestedv1.Post{}).ProtoReflect().Descriptor()
+	conn := appsyncjson.Connection{
+		PageSize: "page_size", PageToken: "page_token", Items: "posts", NextPageToken: "next_page_token",
+	}
+
+	It("should page through the items with cursors", func() {
+		var req nestedv1.ListPostsRequest
+		page, err := appsyncjson.UnmarshalOptions{}.UnmarshalConnection([]byte(`{"first":2}`), &req, conn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proto.Equal(&req, &nestedv1.ListPostsRequest{PageSize: 2})).To(BeTrue())
+
+		data, err := appsyncjson.MarshalOptions{}.MarshalConnection(&nestedv1.ListPostsResponse{
+			Posts:         []*nestedv1.Post{{Id: "a"}, {Id: "b"}},
+			NextPageToken: "next",
+		}, conn, page)
+		Expect(err).ToNot(HaveOccurred())
+
+		var out struct {
+			Edges []struct {
+				Node   map[string]any `json:"node"`
+				Cursor string         `json:"cursor"`
+			} `json:"edges"`
+			PageInfo map[string]any `json:"pageInfo"`
+		}
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out.Edges).To(HaveLen(2))
+		Expect(out.Edges[0].Node).To(HaveKeyWithValue("id", appsyncjson.GlobalID(postDesc, "a")))
+		Expect(out.PageInfo).To(Equal(map[string]any{
+			"hasNextPage": true, "hasPreviousPage": false,
+			"startCursor": out.Edges[0].Cursor, "endCursor": out.Edges[1].Cursor,
+		}))
+
+		// after the last edge, the next page is requested
+		page, err = appsyncjson.UnmarshalOptions{}.UnmarshalConnection(
+			[]byte(`{"first":1,"after":"`+out.Edges[1].Cursor+`"}`), &req, conn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proto.Equal(&req, &nestedv1.ListPostsRequest{PageSize: 1, PageToken: "next"})).To(BeTrue())
+
+		// after the first edge, the first page is requested again and its first item skipped
+		page, err = appsyncjson.UnmarshalOptions{}.UnmarshalConnection(
+			[]byte(`{"first":1,"after":"`+out.Edges[0].Cursor+`"}`), &req, conn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proto.Equal(&req, &nestedv1.ListPostsRequest{PageSize: 2})).To(BeTrue())
+
+		data, err = appsyncjson.MarshalOptions{}.MarshalConnection(&nestedv1.ListPostsResponse{
+			Posts: []*nestedv1.Post{{Id: "a"}, {Id: "b"}},
+		}, conn, page)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out.Edges).To(HaveLen(1))
+		Expect(out.Edges[0].Node).To(HaveKeyWithValue("id", appsyncjson.GlobalID(postDesc, "b")))
+		Expect(out.PageInfo).To(HaveKeyWithValue("hasNextPage", false))
+		Expect(out.PageInfo).To(HaveKeyWithValue("hasPreviousPage", true))
+	})
+
+	It("should encode an empty page", func() {
+		data, err := appsyncjson.MarshalOptions{}.MarshalConnection(&nestedv1.ListPostsResponse{}, conn, appsyncjson.Page{First: -1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"edges":[],"pageInfo":{` +
+			`"hasNextPage":false,"hasPreviousPage":false,"startCursor":null,"endCursor":null}}`))
+	})
+
+	DescribeTable("invalid arguments", func(data, expErr string) {
+		var req nestedv1.ListPostsRequest
+		_, err := appsyncjson.UnmarshalOptions{}.UnmarshalConnection([]byte(data), &req, conn)
+		Expect(err).To(MatchError(ContainSubstring(expErr)))
+	},
+		Entry("negative first", `{"first":-1}`, "invalid 'first' argument: -1"),
+		Entry("malformed cursor", `{"after":"Zm9v"}`, "invalid 'after' argument: malformed cursor: Zm9v"),
+		Entry("undecodable cursor", `{"after":"%%"}`, "invalid 'after' argument: failed to decode cursor"),
+	)
+})
+
+var _ = Describe("nodes", func() {
+	postDesc := (&nestedv1.Post{}).ProtoReflect().Descriptor()
+
+	It("should encode and decode the ids of nodes as global ids", func() {
+		gid := appsyncjson.GlobalID(postDesc, "post-1")
+		Expect(gid).To(Equal("ZXhhbXBsZXMubmVzdGVkLnYxLlBvc3Q6cG9zdC0x"))
+
+		data, err := appsyncjson.Marshal(&nestedv1.CreatePostResponse{Id: "post-1", Post: &nestedv1.Post{Id: "post-1"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"id":"post-1","post":{"id":"` + gid + `","related":[],"author":null}}`))
+
+		var msg nestedv1.Post
+		Expect(appsyncjson.Unmarshal([]byte(`{"id":"`+gid+`"}`), &msg)).To(Succeed())
+		Expect(msg.Id).To(Equal("post-1"))
+	})
+
+	It("should reject global ids of other types", func() {
+		var msg nestedv1.Post
+		gid := appsyncjson.GlobalID((&nestedv1.GetPostRequest{}).ProtoReflect().Descriptor(), "post-1")
+		Expect(appsyncjson.Unmarshal([]byte(`{"id":"`+gid+`"}`), &msg)).To(MatchError(
+			"invalid id for 'examples.nested.v1.Post': it identifies a 'examples.nested.v1.GetPostRequest'"))
+	})
+
+	DescribeTable("unmarshal node ids", func(data string, expName, expID, expErr string) {
+		name, id, err := appsyncjson.UnmarshalNodeID([]byte(data))
+		if expErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+			return
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(name)).To(Equal(expName))
+		Expect(id).To(Equal(expID))
+	},
+		Entry("valid", `{"id":"ZXhhbXBsZXMubmVzdGVkLnYxLlBvc3Q6cG9zdC0x"}`, "examples.nested.v1.Post", "post-1", ""),
+		Entry("not base64", `{"id":"%%"}`, "", "", "failed to decode global id"),
+		Entry("no separator", `{"id":"Zm9v"}`, "", "", "malformed global id: Zm9v"),
+		Entry("invalid name", `{"id":"Zm9vLjox"}`, "", "", "malformed global id: Zm9vLjox"),
+	)
+
+	It("should marshal with the type name", func() {
+		data, err := appsyncjson.MarshalOptions{}.MarshalAs(&nestedv1.Post{Id: "post-1"}, "Post")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"__typename":"Post","id":"` + appsyncjson.GlobalID(postDesc, "post-1") +
+			`","related":[],"author":null}`))
+
+		data, err = appsyncjson.MarshalOptions{}.MarshalAs((*nestedv1.Post)(nil), "Post")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`null`))
+	})
+})
+
+var _ = Describe("interfaces", func() {
+	It("should encode interface messages as the member that is set", func() {
+		data, err := appsyncjson.Marshal(&simplev1.ListNotificationsResponse{Notifications: []*simplev1.Notification{
+			{Kind: &simplev1.Notification_Email{Email: &simplev1.EmailNotification{Text: "hi", Address: "a@b.c"}}},
+			{},
+		}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"notifications":[` +
+			`{"__typename":"EmailNotification","text":"hi","address":"a@b.c"},null]}`))
+	})
+
+	It("should encode a top-level interface message as its member", func() {
+		data, err := appsyncjson.Marshal(&simplev1.Notification{
+			Kind: &simplev1.Notification_Sms{Sms: &simplev1.SmsNotification{Text: "hi", Phone: "+31600000000"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"__typename":"SmsNotification","text":"hi","phone":"+31600000000"}`))
+	})
+})
+
+var _ = Describe("defaults", func() {
+	It("should set omitted fields to their default", func() {
+		var msg simplev1.ListNotificationsRequest
+		Expect(appsyncjson.Unmarshal([]byte(`{}`), &msg)).To(Succeed())
+		Expect(msg.Limit).To(Equal(int32(10)))
+
+		Expect(appsyncjson.Unmarshal([]byte(`{"limit":3}`), &msg)).To(Succeed())
+		Expect(msg.Limit).To(Equal(int32(3)))
+	})
+
+	It("should return the default as protojson decodes it", func() {
+		fd := (&simplev1.ListNotificationsRequest{}).ProtoReflect().Descriptor().Fields().ByName("limit")
+		v, err := appsyncjson.DefaultValue(fd)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(v).To(Equal(json.Number("10")))
+	})
+})
+
+var _ = Describe("result fields", func() {
+	It("should encode the value of a single field", func() {
+		data, err := (appsyncjson.MarshalOptions{}).MarshalField(&simplev1.VersionResponse{Version: "v1"}, "version")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`"v1"`))
+
+		data, err = (appsyncjson.MarshalOptions{}).MarshalField(&nestedv1.RelatedPostsResponse{}, "posts")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`[]`))
+	})
+
+	It("should error for unknown fields", func() {
+		_, err := (appsyncjson.MarshalOptions{}).MarshalField(&simplev1.VersionResponse{}, "foo")
+		Expect(err).To(MatchError("field 'foo' not found in 'examples.simple.v1.VersionResponse'"))
+	})
+})
@@ -0,0 +1,214 @@
+package appsyncjson
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Connection describes how the messages of a paginated rpc method map onto a Relay cursor connection:
+// https://relay.dev/graphql/connections.htm
+type Connection struct {
+	// PageSize and PageToken are the (proto) names of the request fields that select the page
+	PageSize, PageToken protoreflect.Name
+
+	// Items and NextPageToken are the (proto) names of the response fields that hold the nodes and the
+	// token of the next page.
+	Items, NextPageToken protoreflect.Name
+}
+
+// Page is the position in the paginated results that the arguments of a connection field point to
+type Page struct {
+	// Token is the page token that is requested
+	Token string
+
+	// Skip is the number of items that are skipped from the start of the requested page
+	Skip int
+
+	// First is the maximum number of edges, it is negative if there is no maximum
+	First int
+}
+
+// UnmarshalConnection decodes the arguments of a connection field into the request message. The "first"
+// and "after" arguments are set as the page size and page token of the request, the returned page is
+// needed to encode the response.
+func (o UnmarshalOptions) UnmarshalConnection(data []byte, m proto.Message, c Connection) (p Page, err error) {
+	p.First = -1
+
+	args := map[string]any{}
+	if len(strings.TrimSpace(string(data))) > 0 {
+		v, err := decodeJSON(data)
+		if err != nil {
+			return p, err
+		}
+
+		args, _ = v.(map[string]any)
+		if args == nil {
+			args = map[string]any{}
+		}
+	}
+
+	if first, ok := args["first"].(json.Number); ok {
+		n, err := strconv.Atoi(first.String())
+		if err != nil || n < 0 {
+			return p, fmt.Errorf("invalid 'first' argument: %s", first)
+		}
+
+		p.First = n
+	}
+
+	if after, ok := args["after"].(string); ok {
+		if p.Token, p.Skip, err = decodeCursor(after); err != nil {
+			return p, fmt.Errorf("invalid 'after' argument: %w", err)
+		}
+	}
+
+	for _, name := range []string{"first", "after"} {
+		delete(args, name)
+	}
+
+	if data, err = json.Marshal(args); err != nil {
+		return p, fmt.Errorf("failed to encode json: %w", err)
+	}
+
+	if err = o.Unmarshal(data, m); err != nil {
+		return p, err
+	}
+
+	// the skipped items are part of the requested page, so they count towards the page size
+	msg := m.ProtoReflect()
+	if p.First >= 0 {
+		fd, err := connectionField(msg.Descriptor(), c.PageSize)
+		if err != nil {
+			return p, err
+		}
+
+		if err = setInt(msg, fd, int64(p.First+p.Skip)); err != nil {
+			return p, err
+		}
+	}
+
+	fd, err := connectionField(msg.Descriptor(), c.PageToken)
+	if err != nil {
+		return p, err
+	}
+
+	msg.Set(fd, protoreflect.ValueOfString(p.Token))
+	return p, nil
+}
+
+// MarshalConnection encodes the response message as a connection of the items on the page. Every edge
+// gets a cursor that points to the items after it, the last edge of the page points to the next page.
+func (o MarshalOptions) MarshalConnection(m proto.Message, c Connection, p Page) ([]byte, error) {
+	msg := m.ProtoReflect()
+	itemsfd, err := connectionField(msg.Descriptor(), c.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	nextfd, err := connectionField(msg.Descriptor(), c.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := o.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, _ := v.(map[string]any)
+	items, _ := obj[o.fieldName(itemsfd)].([]any)
+	next := msg.Get(nextfd).String()
+
+	var nodes []any
+	if p.Skip < len(items) {
+		nodes = items[p.Skip:]
+	}
+
+	truncated := p.First >= 0 && len(nodes) > p.First
+	if truncated {
+		nodes = nodes[:p.First]
+	}
+
+	edges := make([]any, 0, len(nodes))
+	for i, node := range nodes {
+		cursor := encodeCursor(p.Token, p.Skip+i+1)
+		if p.Skip+i+1 == len(items) && next != "" {
+			cursor = encodeCursor(next, 0)
+		}
+
+		edges = append(edges, map[string]any{"node": node, "cursor": cursor})
+	}
+
+	info := map[string]any{
+		"hasNextPage":     truncated || next != "",
+		"hasPreviousPage": p.Token != "" || p.Skip > 0,
+		"startCursor":     nil,
+		"endCursor":       nil,
+	}
+
+	if len(edges) > 0 {
+		info["startCursor"] = edges[0].(map[string]any)["cursor"]
+		info["endCursor"] = edges[len(edges)-1].(map[string]any)["cursor"]
+	}
+
+	return json.Marshal(map[string]any{"edges": edges, "pageInfo": info})
+}
+
+// encodeCursor encodes the position after 'skip' items of the page with 'token' into an opaque cursor
+func encodeCursor(token string, skip int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(skip) + ":" + token))
+}
+
+// decodeCursor decodes a cursor into the page token and the number of items to skip
+func decodeCursor(cursor string) (token string, skip int, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+
+	skips, token, ok := strings.Cut(string(data), ":")
+	if skip, err = strconv.Atoi(skips); !ok || err != nil || skip < 0 {
+		return "", 0, fmt.Errorf("malformed cursor: %s", cursor)
+	}
+
+	return token, skip, nil
+}
+
+// connectionField returns the field of the message with the provided (proto) name
+func connectionField(md protoreflect.MessageDescriptor, name protoreflect.Name) (protoreflect.FieldDescriptor, error) {
+	fd := md.Fields().ByName(name)
+	if fd == nil {
+		return nil, fmt.Errorf("field '%s' not found in '%s'", name, md.FullName())
+	}
+
+	return fd, nil
+}
+
+// setInt sets the integer field of the message to 'n'
+func setInt(msg protoreflect.Message, fd protoreflect.FieldDescriptor, n int64) error {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		msg.Set(fd, protoreflect.ValueOfInt32(int32(n)))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		msg.Set(fd, protoreflect.ValueOfInt64(n))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		msg.Set(fd, protoreflect.ValueOfUint32(uint32(n)))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		msg.Set(fd, protoreflect.ValueOfUint64(uint64(n)))
+	default:
+		return fmt.Errorf("field '%s' is not an integer field", fd.FullName())
+	}
+
+	return nil
+}
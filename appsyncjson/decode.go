@@ -0,0 +1,163 @@
+package appsyncjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Unmarshal decodes json arguments from AppSync into the message using default options
+func Unmarshal(data []byte, m proto.Message) error {
+	return UnmarshalOptions{}.Unmarshal(data, m)
+}
+
+// UnmarshalOptions configures the decoding of AppSync arguments into messages
+type UnmarshalOptions struct {
+	// ValidateScalars checks the values of fields with an overridden scalar type (i.e AWSEmail) against
+	// the scalar's format. AppSync does this as well but this also protects handlers that are called
+	// without going through AppSync.
+	ValidateScalars bool
+
+	// UseProtoNames expects the field names from the proto file instead of their json names, for fields
+	// that don't have their name configured through the "name" field option.
+	UseProtoNames bool
+}
+
+// Unmarshal decodes json arguments from AppSync into the message
+func (o UnmarshalOptions) Unmarshal(data []byte, m proto.Message) error {
+	if len(bytes.TrimSpace(data)) < 1 {
+		data = []byte("{}")
+	}
+
+	v, err := decodeJSON(data)
+	if err != nil {
+		return err
+	}
+
+	// messages without fields have no arguments, which AppSync might provide as null
+	if v == nil {
+		v = map[string]any{}
+	}
+
+	if err := o.decodeMessage(m.ProtoReflect().Descriptor(), v); err != nil {
+		return err
+	}
+
+	if data, err = json.Marshal(v); err != nil {
+		return fmt.Errorf("failed to encode json: %w", err)
+	}
+
+	if err := protojson.Unmarshal(data, m); err != nil {
+		return fmt.Errorf("failed to unmarshal protojson: %w", err)
+	}
+
+	return nil
+}
+
+// decodeMessage re-shapes the value 'v' of a message with descriptor 'md' into protojson
+func (o UnmarshalOptions) decodeMessage(md protoreflect.MessageDescriptor, v any) error {
+	obj, ok := v.(map[string]any)
+	if !ok || isWellKnown(md) {
+		return nil // null, or encoded as a scalar
+	}
+
+	// the members of a oneof are provided through a separate input object, with at most one member set
+	for i := 0; i < md.Oneofs().Len(); i++ {
+		od := md.Oneofs().Get(i)
+		if od.IsSynthetic() {
+			continue
+		}
+
+		ov, ok := obj[OneofFieldName(od, o.UseProtoNames)]
+		if !ok {
+			continue
+		}
+
+		delete(obj, OneofFieldName(od, o.UseProtoNames))
+		members, _ := ov.(map[string]any)
+
+		var set []string
+		for name, mv := range members {
+			if mv == nil {
+				continue
+			}
+
+			set = append(set, name)
+			obj[name] = mv
+		}
+
+		if sort.Strings(set); len(set) > 1 {
+			return fmt.Errorf("oneof '%s' must have at most one member set, got: %v", od.Name(), set)
+		}
+	}
+
+	// the fields are named as in the graphql schema, protojson expects their json names
+	renameFields(md, obj, o.fieldName, jsonName)
+
+	if err := decodeNodeID(md, obj); err != nil {
+		return err
+	}
+
+	if err := decodeDefaults(md, obj); err != nil {
+		return err
+	}
+
+	if o.ValidateScalars {
+		if err := validateScalars(md, obj); err != nil {
+			return err
+		}
+	}
+
+	// maps are provided as a list of key/value entries, protojson decodes them from an object
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if !fd.IsMap() || isJSON(fd) {
+			continue
+		}
+
+		entries, ok := obj[fd.JSONName()].([]any)
+		if !ok {
+			continue
+		}
+
+		vals := make(map[string]any, len(entries))
+		for _, ev := range entries {
+			entry, _ := ev.(map[string]any)
+			k, err := decodeMapKey(entry["key"])
+			if err != nil {
+				return fmt.Errorf("invalid key for map '%s': %w", fd.Name(), err)
+			}
+
+			vals[k] = entry["value"]
+		}
+
+		obj[fd.JSONName()] = vals
+	}
+
+	return eachMessageValue(md, obj, o.decodeMessage)
+}
+
+// fieldName returns the name of the field in the graphql schema
+func (o UnmarshalOptions) fieldName(fd protoreflect.FieldDescriptor) string {
+	return FieldName(fd, o.UseProtoNames)
+}
+
+// decodeMapKey turns the value of a map entry's "key" field into the object key that protojson expects
+func decodeMapKey(v any) (string, error) {
+	switch kv := v.(type) {
+	case string:
+		return kv, nil
+	case json.Number:
+		return kv.String(), nil
+	case bool:
+		return strconv.FormatBool(kv), nil
+	default:
+		return "", fmt.Errorf("unsupported key: %v", v)
+	}
+}
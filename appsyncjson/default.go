@@ -0,0 +1,115 @@
+package appsyncjson
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultValue returns the value of the field's "default" option as protojson decodes it, or nil if the
+// field has no default. It returns an error if the default is not a valid value for the field.
+func DefaultValue(fd protoreflect.FieldDescriptor) (v any, err error) {
+	opts := fieldOptions(fd)
+	if opts == nil || opts.Default == nil {
+		return nil, nil
+	}
+
+	switch od := fd.ContainingOneof(); {
+	case fd.Cardinality() == protoreflect.Repeated:
+		return nil, fmt.Errorf("repeated fields can't have a default")
+	case od != nil && !od.IsSynthetic():
+		return nil, fmt.Errorf("members of oneof '%s' can't have a default", od.Name())
+	}
+
+	s := opts.GetDefault()
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		v = s
+	case protoreflect.BytesKind:
+		_, err = base64.StdEncoding.DecodeString(s)
+		v = s
+	case protoreflect.BoolKind:
+		if s != "true" && s != "false" {
+			err = fmt.Errorf("not a boolean")
+		}
+
+		v = s == "true"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		_, err = strconv.ParseInt(s, 10, 32)
+		v = json.Number(s)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		_, err = strconv.ParseUint(s, 10, 32)
+		v = json.Number(s)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		_, err = strconv.ParseInt(s, 10, 64)
+		v = s // protojson encodes 64-bit integers as strings
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		_, err = strconv.ParseUint(s, 10, 64)
+		v = s
+	case protoreflect.FloatKind:
+		_, err = strconv.ParseFloat(s, 32)
+		v = json.Number(s)
+	case protoreflect.DoubleKind:
+		_, err = strconv.ParseFloat(s, 64)
+		v = json.Number(s)
+	case protoreflect.EnumKind:
+		val := fd.Enum().Values().ByName(protoreflect.Name(s))
+		switch {
+		case val == nil:
+			return nil, fmt.Errorf("enum '%s' has no value '%s'", fd.Enum().FullName(), s)
+		case val.Number() == 0 && enumOptions(fd.Enum()).GetOmitZero():
+			return nil, fmt.Errorf("zero value '%s' of enum '%s' is not exposed", s, fd.Enum().FullName())
+		}
+
+		v = s
+	default:
+		return nil, fmt.Errorf("fields of kind '%s' can't have a default", fd.Kind())
+	}
+
+	// numbers must also be valid json (and graphql) numbers, which Go's parsing is more lenient about
+	if n, ok := v.(json.Number); ok && err == nil && !isNumber(string(n)) {
+		err = fmt.Errorf("not a number")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid default '%s' for field of kind '%s': %w", s, fd.Kind(), err)
+	}
+
+	if sc, ok := scalars[opts.GetType()]; ok && sc.validate != nil {
+		if err := sc.validate(s); err != nil {
+			return nil, fmt.Errorf("invalid %s default '%s': %w", opts.GetType(), s, err)
+		}
+	}
+
+	return v, nil
+}
+
+// decodeDefaults sets the fields that are omitted from the arguments to their default, like AppSync does
+// for the arguments and input fields of the schema. Fields that are provided as null are left as-is.
+func decodeDefaults(md protoreflect.MessageDescriptor, obj map[string]any) error {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if _, ok := obj[fd.JSONName()]; ok || fieldOptions(fd).GetIgnore() {
+			continue
+		}
+
+		v, err := DefaultValue(fd)
+		if err != nil {
+			return fmt.Errorf("invalid default for field '%s': %w", fd.Name(), err)
+		} else if v != nil {
+			obj[fd.JSONName()] = v
+		}
+	}
+
+	return nil
+}
+
+// isNumber returns whether s is a json number
+func isNumber(s string) bool {
+	v, err := decodeJSON([]byte(s))
+	_, ok := v.(json.Number)
+	return err == nil && ok
+}
@@ -0,0 +1,333 @@
+// Package appsyncjson encodes protobuf messages into the json that the generated graphql schema
+// describes, and decodes AppSync arguments into protobuf messages. It uses protojson but re-shapes
+// the json for the parts of protobuf that have no direct graphql equivalent.
+package appsyncjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	appsyncv1 "github.com/crewlinker/protoc-gen-appsync-go/proto/appsync/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Marshal encodes the message into json for AppSync using default options
+func Marshal(m proto.Message) ([]byte, error) {
+	return MarshalOptions{}.Marshal(m)
+}
+
+// MarshalOptions configures the encoding of messages into json for AppSync
+type MarshalOptions struct {
+	// UseProtoNames uses the field names from the proto file instead of their json names, for fields
+	// that don't have their name configured through the "name" field option.
+	UseProtoNames bool
+
+	// EmptyAsBoolean encodes messages without fields as true, for schemas that expose them as a Boolean
+	EmptyAsBoolean bool
+}
+
+// Marshal encodes the message into json for AppSync
+func (o MarshalOptions) Marshal(m proto.Message) ([]byte, error) {
+
+	// zero values are emitted, else non-null graphql fields would resolve to null
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal protojson: %w", err)
+	}
+
+	v, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.encodeMessage(m.ProtoReflect().Descriptor(), v); err != nil {
+		return nil, err
+	}
+
+	if o.EmptyAsBoolean && isEmpty(m.ProtoReflect().Descriptor()) {
+		v = true
+	}
+
+	if isInterface(m.ProtoReflect().Descriptor()) {
+		v = interfaceValue(m.ProtoReflect().Descriptor(), v)
+	}
+
+	return json.Marshal(v)
+}
+
+// MarshalField encodes the value of a single field of the message, the field with the provided (proto)
+// name. It is encoded like Marshal encodes it as part of the message.
+func (o MarshalOptions) MarshalField(m proto.Message, name protoreflect.Name) ([]byte, error) {
+	fd, err := connectionField(m.ProtoReflect().Descriptor(), name)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := o.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("message '%s' is not encoded as an object", m.ProtoReflect().Descriptor().FullName())
+	}
+
+	return json.Marshal(obj[o.fieldName(fd)])
+}
+
+// encodeMessage re-shapes the protojson value 'v' of a message with descriptor 'md'
+func (o MarshalOptions) encodeMessage(md protoreflect.MessageDescriptor, v any) error {
+	obj, ok := v.(map[string]any)
+	if !ok || isWellKnown(md) {
+		return nil // null, or encoded as a scalar
+	}
+
+	if err := eachMessageValue(md, obj, o.encodeMessage); err != nil {
+		return err
+	}
+
+	// interfaces are replaced by their member as a whole, by the message that holds them
+	if isInterface(md) {
+		return nil
+	}
+
+	encodeScalars(md, obj)
+	encodeEnums(md, obj)
+	encodeNodeID(md, obj)
+	if o.EmptyAsBoolean {
+		encodeEmpty(md, obj)
+	}
+
+	encodeInterfaces(md, obj)
+
+	// maps are described as a list of key/value entries, protojson encodes them as an object
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if !fd.IsMap() || isJSON(fd) {
+			continue
+		}
+
+		if obj[fd.JSONName()] == nil {
+			continue
+		}
+
+		vals, _ := obj[fd.JSONName()].(map[string]any)
+		keys := make([]string, 0, len(vals))
+		for k := range vals {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+		entries := make([]any, 0, len(keys))
+		for _, k := range keys {
+			entries = append(entries, map[string]any{"key": encodeMapKey(fd.MapKey(), k), "value": vals[k]})
+		}
+
+		obj[fd.JSONName()] = entries
+	}
+
+	// the output describes which member of a oneof is set, protojson only emits the member itself
+	for i := 0; i < md.Oneofs().Len(); i++ {
+		od := md.Oneofs().Get(i)
+		if od.IsSynthetic() {
+			continue
+		}
+
+		obj[OneofCaseFieldName(od, o.UseProtoNames)] = nil
+		for j := 0; j < od.Fields().Len(); j++ {
+			if _, ok := obj[od.Fields().Get(j).JSONName()]; ok {
+				obj[OneofCaseFieldName(od, o.UseProtoNames)] = OneofCaseValue(od.Fields().Get(j))
+			}
+		}
+	}
+
+	// lastly, the fields are renamed to their name in the graphql schema
+	renameFields(md, obj, jsonName, o.fieldName)
+
+	return nil
+}
+
+// fieldName returns the name of the field in the graphql schema
+func (o MarshalOptions) fieldName(fd protoreflect.FieldDescriptor) string {
+	return FieldName(fd, o.UseProtoNames)
+}
+
+// eachMessageValue calls fn for every value in the message's json object that holds a message
+func eachMessageValue(
+	md protoreflect.MessageDescriptor, obj map[string]any, fn func(protoreflect.MessageDescriptor, any) error,
+) error {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		fv, ok := obj[fd.JSONName()]
+		if !ok || isJSON(fd) {
+			continue // not present, or exposed as its plain protojson encoding
+		}
+
+		switch {
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			vals, _ := fv.(map[string]any)
+			for _, ev := range vals {
+				if err := fn(fd.MapValue().Message(), ev); err != nil {
+					return err
+				}
+			}
+		case fd.IsList() && fd.Message() != nil:
+			elems, _ := fv.([]any)
+			for _, ev := range elems {
+				if err := fn(fd.Message(), ev); err != nil {
+					return err
+				}
+			}
+		case !fd.IsMap() && fd.Message() != nil:
+			if err := fn(fd.Message(), fv); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// encodeMapKey turns the object key that protojson uses for map entries into the value of the entry's
+// "key" field. Protojson encodes 64-bit integers as strings so those keys are kept as-is.
+func encodeMapKey(fd protoreflect.FieldDescriptor, k string) any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return k == "true"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return json.Number(k)
+	default:
+		return k
+	}
+}
+
+// encodeEnums replaces the zero values of enums that omit it from the schema with null
+func encodeEnums(md protoreflect.MessageDescriptor, obj map[string]any) {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		vd := fd
+		if fd.IsMap() {
+			vd = fd.MapValue()
+		}
+
+		if vd.Enum() == nil || !enumOptions(vd.Enum()).GetOmitZero() {
+			continue
+		}
+
+		// proto2 enums don't need a zero value, then there is nothing to omit
+		zval := vd.Enum().Values().ByNumber(0)
+		if zval == nil {
+			continue
+		}
+
+		zero := string(zval.Name())
+		replaceValues(fd, obj, func(v any) any {
+			if v == zero {
+				return nil
+			}
+
+			return v
+		})
+	}
+}
+
+// encodeEmpty replaces the values of fields that hold messages without fields with true
+func encodeEmpty(md protoreflect.MessageDescriptor, obj map[string]any) {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		vd := fd
+		if fd.IsMap() {
+			vd = fd.MapValue()
+		}
+
+		if vd.Message() == nil || isJSON(fd) || !isEmpty(vd.Message()) {
+			continue
+		}
+
+		replaceValues(fd, obj, func(v any) any { return v != nil })
+	}
+}
+
+// replaceValues replaces the value of a field with the result of fn. For lists it replaces each
+// element and for maps it replaces each value.
+func replaceValues(fd protoreflect.FieldDescriptor, obj map[string]any, fn func(any) any) {
+	switch fv := obj[fd.JSONName()].(type) {
+	case []any:
+		for j, ev := range fv {
+			fv[j] = fn(ev)
+		}
+	case map[string]any:
+		if !fd.IsMap() {
+			obj[fd.JSONName()] = fn(fv)
+			return
+		}
+
+		for k, ev := range fv {
+			fv[k] = fn(ev)
+		}
+	case nil:
+		return // not present, or null
+	default:
+		obj[fd.JSONName()] = fn(fv)
+	}
+}
+
+// isEmpty returns whether the message has no fields in the graphql schema
+func isEmpty(md protoreflect.MessageDescriptor) bool {
+	if isWellKnown(md) {
+		return false // encoded as a scalar
+	}
+
+	for i := 0; i < md.Fields().Len(); i++ {
+		if !fieldOptions(md.Fields().Get(i)).GetIgnore() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isJSON returns whether the field is configured to be exposed as its plain protojson encoding
+func isJSON(fd protoreflect.FieldDescriptor) bool {
+	return fieldOptions(fd).GetJson()
+}
+
+// fieldOptions returns our plugin specific options for a field, nil if it has none
+func fieldOptions(fd protoreflect.FieldDescriptor) *appsyncv1.FieldOptions {
+	opts, _ := proto.GetExtension(fd.Options(), appsyncv1.E_Field).(*appsyncv1.FieldOptions)
+	return opts
+}
+
+// messageOptions returns our plugin specific options for a message, nil if it has none
+func messageOptions(md protoreflect.MessageDescriptor) *appsyncv1.MessageOptions {
+	opts, _ := proto.GetExtension(md.Options(), appsyncv1.E_Message).(*appsyncv1.MessageOptions)
+	return opts
+}
+
+// enumOptions returns our plugin specific options for an enum, nil if it has none
+func enumOptions(ed protoreflect.EnumDescriptor) *appsyncv1.EnumOptions {
+	opts, _ := proto.GetExtension(ed.Options(), appsyncv1.E_Enum).(*appsyncv1.EnumOptions)
+	return opts
+}
+
+// decodeJSON decodes data into a generic value, keeping the exact representation of numbers
+func decodeJSON(data []byte) (v any, err error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err = dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode json: %w", err)
+	}
+
+	return v, nil
+}
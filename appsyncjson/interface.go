@@ -0,0 +1,68 @@
+package appsyncjson
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TypeNameFieldName is the name of the graphql field that tells AppSync the type of an interface value
+const TypeNameFieldName = "__typename"
+
+// encodeInterfaces replaces the values of fields that hold an interface message with the member that is set
+func encodeInterfaces(md protoreflect.MessageDescriptor, obj map[string]any) {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		vd := fd
+		if fd.IsMap() {
+			vd = fd.MapValue()
+		}
+
+		if vd.Message() == nil || isJSON(fd) || !isInterface(vd.Message()) {
+			continue
+		}
+
+		replaceValues(fd, obj, func(v any) any { return interfaceValue(vd.Message(), v) })
+	}
+}
+
+// interfaceValue returns the encoded member of the interface message that is set, with the name of its
+// type. It returns nil if no member is set.
+func interfaceValue(md protoreflect.MessageDescriptor, v any) any {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	od := interfaceOneof(md)
+	if od == nil {
+		return nil
+	}
+
+	for i := 0; i < od.Fields().Len(); i++ {
+		fd := od.Fields().Get(i)
+		member, ok := obj[fd.JSONName()].(map[string]any)
+		if !ok || fd.Message() == nil {
+			continue
+		}
+
+		member[TypeNameFieldName] = TypeName(fd.Message())
+		return member
+	}
+
+	return nil
+}
+
+// interfaceOneof returns the oneof of an interface message that holds its implementations
+func interfaceOneof(md protoreflect.MessageDescriptor) protoreflect.OneofDescriptor {
+	for i := 0; i < md.Oneofs().Len(); i++ {
+		if od := md.Oneofs().Get(i); !od.IsSynthetic() {
+			return od
+		}
+	}
+
+	return nil
+}
+
+// isInterface returns whether the message is exposed as a graphql interface
+func isInterface(md protoreflect.MessageDescriptor) bool {
+	return messageOptions(md).GetInterface()
+}
@@ -0,0 +1,147 @@
+package appsyncjson
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldName returns the name of the graphql field for a protobuf field. The id field of a Relay node is
+// always named "id". Otherwise it is the "name" field option if that is set, or else the json name or the
+// name in the proto file if useProtoNames is true.
+func FieldName(fd protoreflect.FieldDescriptor, useProtoNames bool) string {
+	switch {
+	case isNodeID(fd):
+		return NodeIDFieldName
+	case fieldOptions(fd).GetName() != "":
+		return fieldOptions(fd).GetName()
+	case useProtoNames:
+		return string(fd.Name())
+	default:
+		return fd.JSONName()
+	}
+}
+
+// TypeName returns the name of the graphql type for a message or enum. It is the "name" message or enum
+// option if that is set, or else the name of its Go type.
+func TypeName(d protoreflect.Descriptor) string {
+	var name string
+	switch d := d.(type) {
+	case protoreflect.MessageDescriptor:
+		name = messageOptions(d).GetName()
+	case protoreflect.EnumDescriptor:
+		name = enumOptions(d).GetName()
+	}
+
+	if name != "" {
+		return name
+	}
+
+	return goCamelCase(strings.TrimPrefix(string(d.FullName()), string(d.ParentFile().Package())+"."))
+}
+
+// OneofFieldName returns the name of the graphql input field that holds the members of the oneof
+func OneofFieldName(od protoreflect.OneofDescriptor, useProtoNames bool) string {
+	if useProtoNames {
+		return string(od.Name())
+	}
+
+	return jsonCamelCase(string(od.Name()))
+}
+
+// OneofCaseFieldName returns the name of the graphql output field that tells which member of the
+// oneof is set.
+func OneofCaseFieldName(od protoreflect.OneofDescriptor, useProtoNames bool) string {
+	if useProtoNames {
+		return OneofFieldName(od, useProtoNames) + "_case"
+	}
+
+	return OneofFieldName(od, useProtoNames) + "Case"
+}
+
+// OneofCaseValue returns the graphql enum value that identifies the oneof member
+func OneofCaseValue(fd protoreflect.FieldDescriptor) string {
+	return strings.ToUpper(string(fd.Name()))
+}
+
+// jsonCamelCase turns a proto name into camel case, the same way protoc derives json names
+func jsonCamelCase(s string) string {
+	var b strings.Builder
+	var upper bool
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '_':
+			upper = true
+		case upper && 'a' <= c && c <= 'z':
+			b.WriteByte(c - 'a' + 'A')
+			upper = false
+		default:
+			b.WriteByte(c)
+			upper = false
+		}
+	}
+
+	return b.String()
+}
+
+// goCamelCase turns the name of a message relative to its package into the name of its Go type, the
+// same way protoc-gen-go does.
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '.' && i+1 < len(s) && isLower(s[i+1]):
+			// skip over '.' in ".{{lowercase}}"
+		case c == '.':
+			b = append(b, '_')
+		case c == '_' && (i == 0 || s[i-1] == '.'):
+			b = append(b, 'X') // start with a capital letter
+		case c == '_' && i+1 < len(s) && isLower(s[i+1]):
+			// skip over '_' in "_{{lowercase}}"
+		case '0' <= c && c <= '9':
+			b = append(b, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+
+			b = append(b, c)
+			for ; i+1 < len(s) && isLower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+
+	return string(b)
+}
+
+// isLower returns whether the byte is a lower case ascii letter
+func isLower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+// renameFields moves the values of the message's fields from the key returned by 'from' to the key returned
+// by 'to'. Other keys are left as-is.
+func renameFields(md protoreflect.MessageDescriptor, obj map[string]any, from, to func(protoreflect.FieldDescriptor) string) {
+	renamed := map[string]any{}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if from(fd) == to(fd) {
+			continue
+		}
+
+		if v, ok := obj[from(fd)]; ok {
+			renamed[to(fd)] = v
+			delete(obj, from(fd))
+		}
+	}
+
+	for k, v := range renamed {
+		obj[k] = v
+	}
+}
+
+// jsonName returns the json name of the field that protojson uses
+func jsonName(fd protoreflect.FieldDescriptor) string {
+	return fd.JSONName()
+}
@@ -0,0 +1,127 @@
+package appsyncjson
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NodeIDFieldName is the name of the graphql field that holds the global id of a Relay node
+const NodeIDFieldName = "id"
+
+// GlobalID encodes the id of a message that is a Relay node into an opaque id that is unique across all
+// types. It holds the full name of the message and its id.
+func GlobalID(md protoreflect.MessageDescriptor, id string) string {
+	return base64.StdEncoding.EncodeToString([]byte(string(md.FullName()) + ":" + id))
+}
+
+// ParseGlobalID decodes a global id into the full name of the message and its id
+func ParseGlobalID(gid string) (name protoreflect.FullName, id string, err error) {
+	data, err := base64.StdEncoding.DecodeString(gid)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode global id: %w", err)
+	}
+
+	names, id, ok := strings.Cut(string(data), ":")
+	if name = protoreflect.FullName(names); !ok || !name.IsValid() {
+		return "", "", fmt.Errorf("malformed global id: %s", gid)
+	}
+
+	return name, id, nil
+}
+
+// UnmarshalNodeID decodes the arguments of the "node" query field into the full name of the message that
+// is fetched and its id.
+func UnmarshalNodeID(data []byte) (name protoreflect.FullName, id string, err error) {
+	var args struct {
+		ID string `json:"id"`
+	}
+
+	if err = json.NewDecoder(bytes.NewReader(data)).Decode(&args); err != nil {
+		return "", "", fmt.Errorf("failed to decode json: %w", err)
+	}
+
+	return ParseGlobalID(args.ID)
+}
+
+// MarshalAs encodes the message like Marshal does and adds the name of its graphql type as the
+// "__typename" field. AppSync needs it to resolve the type of fields that return an interface.
+func (o MarshalOptions) MarshalAs(m proto.Message, typeName string) ([]byte, error) {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return []byte("null"), nil
+	}
+
+	data, err := o.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("message '%s' is not encoded as an object", m.ProtoReflect().Descriptor().FullName())
+	}
+
+	obj[TypeNameFieldName] = typeName
+	return json.Marshal(obj)
+}
+
+// encodeNodeID replaces the id of a Relay node with its global id
+func encodeNodeID(md protoreflect.MessageDescriptor, obj map[string]any) {
+	fd := nodeIDField(md)
+	if fd == nil {
+		return
+	}
+
+	if id, ok := obj[fd.JSONName()].(string); ok {
+		obj[fd.JSONName()] = GlobalID(md, id)
+	}
+}
+
+// decodeNodeID replaces the global id of a Relay node with its id
+func decodeNodeID(md protoreflect.MessageDescriptor, obj map[string]any) error {
+	fd := nodeIDField(md)
+	if fd == nil {
+		return nil
+	}
+
+	gid, ok := obj[fd.JSONName()].(string)
+	if !ok {
+		return nil
+	}
+
+	name, id, err := ParseGlobalID(gid)
+	if err != nil {
+		return fmt.Errorf("invalid id for '%s': %w", md.FullName(), err)
+	} else if name != md.FullName() {
+		return fmt.Errorf("invalid id for '%s': it identifies a '%s'", md.FullName(), name)
+	}
+
+	obj[fd.JSONName()] = id
+	return nil
+}
+
+// nodeIDField returns the id field of a message that is a Relay node, nil if the message is not a node
+func nodeIDField(md protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	opts := messageOptions(md).GetNode()
+	if opts == nil {
+		return nil
+	}
+
+	return md.Fields().ByName(protoreflect.Name(opts.GetId()))
+}
+
+// isNodeID returns whether the field holds the id of a message that is a Relay node
+func isNodeID(fd protoreflect.FieldDescriptor) bool {
+	md, ok := fd.Parent().(protoreflect.MessageDescriptor)
+	return ok && nodeIDField(md) == fd
+}
@@ -0,0 +1,157 @@
+package appsyncjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// scalar describes a graphql scalar that a field's type can be overridden with
+type scalar struct {
+	// kinds of protobuf fields that are compatible with the scalar
+	kinds []protoreflect.Kind
+	// validate checks a (protojson) string value against the scalar's format, may be nil
+	validate func(string) error
+}
+
+var (
+	stringKinds  = []protoreflect.Kind{protoreflect.StringKind}
+	integerKinds = []protoreflect.Kind{
+		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+	}
+
+	// https://docs.aws.amazon.com/appsync/latest/devguide/scalars.html
+	awsPhoneFormat = regexp.MustCompile(`^\+?[0-9][0-9 ().-]*$`)
+	awsTimeFormat  = regexp.MustCompile(`^\d{2}:\d{2}(:\d{2}(\.\d{1,9})?)?(Z|[+-]\d{2}:\d{2}(:\d{2})?)?$`)
+	awsDateFormat  = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(Z|[+-]\d{2}:\d{2}(:\d{2})?)?$`)
+)
+
+// scalars that fields can be declared as using the "type" field option
+var scalars = map[string]scalar{
+	"ID":           {kinds: append(stringKinds, integerKinds...)},
+	"AWSTimestamp": {kinds: integerKinds},
+	"AWSEmail": {kinds: stringKinds, validate: func(s string) error {
+		addr, err := mail.ParseAddress(s)
+		if err == nil && addr.Address != s {
+			err = fmt.Errorf("not a plain address")
+		}
+		return err
+	}},
+	"AWSURL": {kinds: stringKinds, validate: func(s string) error {
+		u, err := url.Parse(s)
+		if err == nil && u.Scheme == "" {
+			err = fmt.Errorf("missing scheme")
+		}
+		return err
+	}},
+	"AWSPhone": {kinds: stringKinds, validate: func(s string) error {
+		return matchFormat(awsPhoneFormat, s)
+	}},
+	"AWSIPAddress": {kinds: stringKinds, validate: func(s string) error {
+		if _, _, err := net.ParseCIDR(s); err == nil {
+			return nil
+		} else if net.ParseIP(s) == nil {
+			return fmt.Errorf("not an IPv4 or IPv6 address")
+		}
+		return nil
+	}},
+	"AWSDate": {kinds: stringKinds, validate: func(s string) error {
+		if err := matchFormat(awsDateFormat, s); err != nil {
+			return err
+		}
+		_, err := time.Parse("2006-01-02", s[:10])
+		return err
+	}},
+	"AWSTime": {kinds: stringKinds, validate: func(s string) error {
+		return matchFormat(awsTimeFormat, s)
+	}},
+	"AWSDateTime": {kinds: stringKinds, validate: func(s string) error {
+		_, err := time.Parse(time.RFC3339Nano, s)
+		return err
+	}},
+}
+
+// CheckScalar returns an error if 'name' is not a scalar that a field's type can be overridden with, or
+// if it is not compatible with protobuf fields of the provided kind.
+func CheckScalar(name string, kind protoreflect.Kind) error {
+	sc, ok := scalars[name]
+	if !ok {
+		return fmt.Errorf("unsupported scalar type '%s'", name)
+	}
+
+	for _, k := range sc.kinds {
+		if k == kind {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("scalar type '%s' is not compatible with fields of kind '%s'", name, kind)
+}
+
+// matchFormat returns an error if s doesn't match the regular expression
+func matchFormat(re *regexp.Regexp, s string) error {
+	if !re.MatchString(s) {
+		return fmt.Errorf("does not match '%s'", re)
+	}
+
+	return nil
+}
+
+// encodeScalars re-shapes the protojson values of fields with an overridden scalar type. Protojson encodes
+// 64-bit integers as strings but an AWSTimestamp must be a json number.
+func encodeScalars(md protoreflect.MessageDescriptor, obj map[string]any) {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if fieldOptions(fd).GetType() != "AWSTimestamp" {
+			continue
+		}
+
+		switch fv := obj[fd.JSONName()].(type) {
+		case string:
+			obj[fd.JSONName()] = json.Number(fv)
+		case []any:
+			for j, ev := range fv {
+				if s, ok := ev.(string); ok {
+					fv[j] = json.Number(s)
+				}
+			}
+		}
+	}
+}
+
+// validateScalars checks the values of fields with an overridden scalar type against the scalar's format
+func validateScalars(md protoreflect.MessageDescriptor, obj map[string]any) error {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		name := fieldOptions(fd).GetType()
+		if sc, ok := scalars[name]; !ok || sc.validate == nil {
+			continue
+		}
+
+		vals, ok := obj[fd.JSONName()].([]any)
+		if !ok {
+			vals = []any{obj[fd.JSONName()]}
+		}
+
+		for _, v := range vals {
+			s, ok := v.(string)
+			if !ok {
+				continue // null, or a value that protojson will reject
+			}
+
+			if err := scalars[name].validate(s); err != nil {
+				return fmt.Errorf("invalid %s value for field '%s': %w", name, fd.Name(), err)
+			}
+		}
+	}
+
+	return nil
+}
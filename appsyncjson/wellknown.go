@@ -0,0 +1,53 @@
+package appsyncjson
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WellKnownScalar describes the graphql scalar that a well-known protobuf message maps to
+type WellKnownScalar struct {
+	// Name of the graphql scalar type
+	Name string
+	// Nullable is set when protojson can encode the message as a json null
+	Nullable bool
+	// NullValue is set when the value itself can be a json null, also when it is the element of a list
+	NullValue bool
+}
+
+// wellKnownScalars maps the well-known protobuf types onto the graphql scalars that accept what
+// protojson encodes them as. Without this, they would be re-shaped like any other message.
+var wellKnownScalars = map[protoreflect.FullName]WellKnownScalar{
+	"google.protobuf.Timestamp": {Name: "AWSDateTime"}, // RFC 3339, always in UTC
+	"google.protobuf.Duration":  {Name: "String"},      // seconds with a "s" suffix: "1.5s"
+	"google.protobuf.FieldMask": {Name: "String"},      // comma-separated camelCase paths: "foo,barBaz"
+	"google.protobuf.Struct":    {Name: "AWSJSON"},
+	"google.protobuf.ListValue": {Name: "AWSJSON"},
+	"google.protobuf.Value":     {Name: "AWSJSON", Nullable: true, NullValue: true},
+	"google.protobuf.Any":       {Name: "AWSJSON"}, // object with a "@type" member
+	"google.protobuf.Empty":     {Name: "AWSJSON"}, // always the empty object: {}
+
+	// wrappers encode as their wrapped value, and as null when they're not set
+	"google.protobuf.DoubleValue": {Name: "Float", Nullable: true},
+	"google.protobuf.FloatValue":  {Name: "Float", Nullable: true},
+	"google.protobuf.Int64Value":  {Name: "String", Nullable: true},
+	"google.protobuf.UInt64Value": {Name: "String", Nullable: true},
+	"google.protobuf.Int32Value":  {Name: "Int", Nullable: true},
+	"google.protobuf.UInt32Value": {Name: "Float", Nullable: true},
+	"google.protobuf.BoolValue":   {Name: "Boolean", Nullable: true},
+	"google.protobuf.StringValue": {Name: "String", Nullable: true},
+	"google.protobuf.BytesValue":  {Name: "String", Nullable: true},
+}
+
+// WellKnown returns the graphql scalar that a well-known protobuf message maps onto, ok is false if the
+// message is not one of the well-known types that protojson encodes in a special way.
+func WellKnown(md protoreflect.MessageDescriptor) (sc WellKnownScalar, ok bool) {
+	sc, ok = wellKnownScalars[md.FullName()]
+	return sc, ok
+}
+
+// isWellKnown returns whether the message is one of protobuf's well-known types. Protojson encodes
+// them in a special way and the graphql schema describes them as scalars.
+func isWellKnown(md protoreflect.MessageDescriptor) bool {
+	_, ok := WellKnown(md)
+	return ok
+}
@@ -42,7 +42,12 @@ func WithAppSync(s constructs.Construct, name string, resolves []string) {
 	// Setup the AppSync api
 	api := awsappsync.NewCfnGraphQLApi(s, jsii.String("Api"), &awsappsync.CfnGraphQLApiProps{
 		AuthenticationType: jsii.String("API_KEY"),
-		Name:               jsii.String(*awscdk.Stack_Of(s).StackName() + name + "Graph"),
+		AdditionalAuthenticationProviders: []interface{}{
+			&awsappsync.CfnGraphQLApi_AdditionalAuthenticationProviderProperty{
+				AuthenticationType: jsii.String("AWS_IAM"),
+			},
+		},
+		Name: jsii.String(*awscdk.Stack_Of(s).StackName() + name + "Graph"),
 	})
 
 	// setup an api key so we  can use the AWS query interface
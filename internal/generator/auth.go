@@ -0,0 +1,170 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	appsyncv1 "github.com/crewlinker/protoc-gen-appsync-go/proto/appsync/v1"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AuthMode is one of the AppSync authorization modes
+type AuthMode string
+
+const (
+	// AuthAPIKey authorizes with an API key
+	AuthAPIKey AuthMode = "api_key"
+	// AuthIAM authorizes with IAM credentials
+	AuthIAM AuthMode = "iam"
+	// AuthCognitoUserPools authorizes users of a Cognito user pool
+	AuthCognitoUserPools AuthMode = "cognito_user_pools"
+	// AuthOIDC authorizes with an OpenID Connect token
+	AuthOIDC AuthMode = "oidc"
+	// AuthLambda authorizes through a Lambda authorizer
+	AuthLambda AuthMode = "lambda"
+)
+
+// authDirectives maps the authorization modes onto their AppSync directive
+var authDirectives = map[AuthMode]string{
+	AuthAPIKey:           "aws_api_key",
+	AuthIAM:              "aws_iam",
+	AuthCognitoUserPools: "aws_cognito_user_pools",
+	AuthOIDC:             "aws_oidc",
+	AuthLambda:           "aws_lambda",
+}
+
+// defaultAuthDirectives returns the directives for object types that have no authorization configured
+func (tg *Target) defaultAuthDirectives() ast.DirectiveList {
+	if tg.gen.opts.DefaultAuth == "" {
+		return nil
+	}
+
+	return ast.DirectiveList{{Name: authDirectives[tg.gen.opts.DefaultAuth]}}
+}
+
+// generateAuth generates the AppSync authorization directives for the auth option. It returns nil
+// if the option is not set.
+func generateAuth(auth *appsyncv1.Auth) (dirs ast.DirectiveList, err error) {
+	if auth == nil {
+		return nil, nil
+	}
+
+	if len(auth.CognitoGroups) > 0 && auth.CognitoUserPools != nil && !auth.GetCognitoUserPools() {
+		return nil, fmt.Errorf("cognito groups require cognito user pools to be allowed")
+	}
+
+	for _, mode := range []struct {
+		mode    AuthMode
+		allowed bool
+	}{
+		{AuthAPIKey, auth.GetApiKey()},
+		{AuthIAM, auth.GetIam()},
+		{AuthCognitoUserPools, auth.GetCognitoUserPools() || len(auth.CognitoGroups) > 0},
+		{AuthOIDC, auth.GetOidc()},
+		{AuthLambda, auth.GetLambda()},
+	} {
+		if !mode.allowed {
+			continue
+		}
+
+		dir := &ast.Directive{Name: authDirectives[mode.mode]}
+		if mode.mode == AuthCognitoUserPools && len(auth.CognitoGroups) > 0 {
+			groups := &ast.Value{Kind: ast.ListValue}
+			for _, group := range auth.CognitoGroups {
+				groups.Children = append(groups.Children, &ast.ChildValue{
+					Value: &ast.Value{Kind: ast.StringValue, Raw: group},
+				})
+			}
+
+			dir.Arguments = ast.ArgumentList{{Name: "cognito_groups", Value: groups}}
+		}
+
+		dirs = append(dirs, dir)
+	}
+
+	if len(dirs) < 1 {
+		return nil, fmt.Errorf("auth option must allow at least one authorization mode")
+	}
+
+	return dirs, nil
+}
+
+// useAuth records that an element with an auth option is generated, as input or as part of an object type
+func (tg *Target) useAuth(desc protoreflect.Descriptor, auth *appsyncv1.Auth, isInput bool) {
+	if auth == nil {
+		return
+	}
+
+	tg.auths[desc] = tg.auths[desc] || !isInput
+}
+
+// checkInputAuth reports the auth options of elements that are only used as input, where they would have
+// no effect. They are ordered by the name of the element.
+func (tg *Target) checkInputAuth() {
+	descs := make([]protoreflect.Descriptor, 0, len(tg.auths))
+	for desc, output := range tg.auths {
+		if !output {
+			descs = append(descs, desc)
+		}
+	}
+
+	sort.Slice(descs, func(i, j int) bool { return descs[i].FullName() < descs[j].FullName() })
+	for _, desc := range descs {
+		tg.report(desc, fmt.Errorf("auth option of '%s' has no effect, since it is only used as input",
+			desc.FullName()))
+	}
+}
+
+// copyDirectives returns a copy of the directive list, so it can be changed without affecting the
+// definition it was copied from
+func copyDirectives(dirs ast.DirectiveList) ast.DirectiveList {
+	if dirs == nil {
+		return nil
+	}
+
+	return append(ast.DirectiveList{}, dirs...)
+}
+
+// mergeAuthDirectives returns the authorization directives that allow everything that either list
+// allows. Cognito groups are combined, and dropped when one of the lists allows all users of the pool.
+func mergeAuthDirectives(dirs, other ast.DirectiveList) ast.DirectiveList {
+	merged := copyDirectives(dirs)
+	for _, dir := range other {
+		idx := -1
+		for i, mdir := range merged {
+			if mdir.Name == dir.Name {
+				idx = i
+			}
+		}
+
+		if idx < 0 {
+			merged = append(merged, dir)
+			continue
+		}
+
+		mgroups, groups := merged[idx].Arguments.ForName("cognito_groups"), dir.Arguments.ForName("cognito_groups")
+		if mgroups == nil {
+			continue // already allows all users
+		} else if groups == nil {
+			merged[idx] = dir
+			continue
+		}
+
+		combined := &ast.Value{Kind: ast.ListValue, Children: append(ast.ChildValueList{}, mgroups.Value.Children...)}
+		for _, group := range groups.Value.Children {
+			seen := false
+			for _, mgroup := range combined.Children {
+				seen = seen || mgroup.Value.Raw == group.Value.Raw
+			}
+
+			if !seen {
+				combined.Children = append(combined.Children, group)
+			}
+		}
+
+		merged[idx] = &ast.Directive{Name: dir.Name, Arguments: ast.ArgumentList{{Name: "cognito_groups", Value: combined}}}
+	}
+
+	return merged
+}
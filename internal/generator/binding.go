@@ -0,0 +1,160 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Resolver is a field of the schema that is resolved by a rpc method
+type Resolver struct {
+	// Qualifier refers to the field in the schema: <Type>.<field>
+	Qualifier string
+	Method    *protogen.Method
+}
+
+// resolverQualifier returns how the "resolves" method option refers to a field: <Message>.<field>
+func resolverQualifier(fld *protogen.Field) string {
+	return fmt.Sprintf("%s.%s", fld.Parent.Desc.Name(), fld.Desc.Name())
+}
+
+// indexResolvers indexes the methods that are configured to resolve a field. A field can be resolved by
+// a single method only, the methods that resolve it as well are reported.
+func (tg *Target) indexResolvers() {
+	for _, svc := range tg.file.Services {
+		for _, met := range svc.Methods {
+			for _, res := range MethodOptions(met).GetResolves() {
+				if other, ok := tg.resolvers.unmapped[res]; ok {
+					tg.report(met.Desc, fmt.Errorf("invalid resolves option: field '%s' is resolved by both rpc '%s' and rpc '%s'",
+						res, other.Desc.FullName(), met.Desc.FullName()))
+					continue
+				}
+
+				// unmapped resolvers will be mapped during schema generation
+				tg.resolvers.unmapped[res] = met
+
+				// map unique services that resolve
+				tg.resolvers.methods[met] = struct{}{}
+				tg.resolvers.services[met.Parent] = struct{}{}
+			}
+		}
+	}
+}
+
+// checkBinding checks that the method's response can be the value of the field it resolves, the field
+// must be a singular field of the response message. With the result_field option the value is taken from
+// a field of the response instead, which must then have the same type as the field. It returns that
+// result field, or nil if the method resolves with its whole response.
+func checkBinding(fld *protogen.Field, met *protogen.Method) (result *protogen.Field, err error) {
+	mopts := MethodOptions(met)
+	if mopts.GetResultField() == "" {
+		if fld.Desc.IsList() || fld.Desc.IsMap() || fld.Message == nil ||
+			fld.Message.Desc.FullName() != met.Output.Desc.FullName() {
+			return nil, fmt.Errorf("field '%s' of type '%s' can't be resolved by rpc '%s', which returns '%s'",
+				fld.Desc.FullName(), protoTypeName(fld), met.Desc.FullName(), met.Output.Desc.FullName())
+		}
+
+		return nil, nil
+	}
+
+	if mopts.GetConnection() != nil {
+		return nil, fmt.Errorf("rpc '%s' can't combine the result_field option with the connection option",
+			met.Desc.FullName())
+	}
+
+	if result = messageField(met.Output, mopts.GetResultField()); result == nil {
+		return nil, fmt.Errorf("result field '%s' of rpc '%s' not found in '%s'",
+			mopts.GetResultField(), met.Desc.FullName(), met.Output.Desc.FullName())
+	}
+
+	// the value is encoded as the result field, so it must be exposed the same way as the resolved field
+	if protoTypeName(result) != protoTypeName(fld) ||
+		FieldOptions(result).GetType() != FieldOptions(fld).GetType() ||
+		FieldOptions(result).GetJson() != FieldOptions(fld).GetJson() {
+		return nil, fmt.Errorf("field '%s' of type '%s' can't be resolved by rpc '%s', its result field '%s' has type '%s'",
+			fld.Desc.FullName(), protoTypeName(fld), met.Desc.FullName(), result.Desc.Name(), protoTypeName(result))
+	}
+
+	return result, nil
+}
+
+// checkUnmapped reports the methods that resolve a field that was not found while generating the schema,
+// ordered by the field that they resolve.
+func (tg *Target) checkUnmapped() {
+	quals := make([]string, 0, len(tg.resolvers.unmapped))
+	for q := range tg.resolvers.unmapped {
+		quals = append(quals, q)
+	}
+
+	sort.Strings(quals)
+	for _, q := range quals {
+		met := tg.resolvers.unmapped[q]
+		if fld, ok := tg.resolvers.inputs[q]; ok {
+			tg.report(met.Desc, fmt.Errorf("field '%s' is only used as input, so it can't be resolved by rpc '%s'",
+				fld.Desc.FullName(), met.Desc.FullName()))
+			continue
+		}
+
+		tg.report(met.Desc, fmt.Errorf("%s.%s resolves field '%s' but it was not found under the root messages (%s, %s or %s)",
+			met.Parent.Desc.Name(), met.Desc.Name(),
+			q,
+			tg.gen.opts.QueryMessageName,
+			tg.gen.opts.MutationMessageName,
+			tg.gen.opts.SubscriptionMessageName))
+	}
+}
+
+// protoTypeName describes the type of a field as it is declared in the proto file
+func protoTypeName(fld *protogen.Field) string {
+	name := fld.Desc.Kind().String()
+	switch {
+	case fld.Message != nil:
+		name = string(fld.Message.Desc.FullName())
+	case fld.Enum != nil:
+		name = string(fld.Enum.Desc.FullName())
+	}
+
+	switch {
+	case fld.Desc.IsMap():
+		return "map<" + protoTypeName(fld.Message.Fields[0]) + ", " + protoTypeName(fld.Message.Fields[1]) + ">"
+	case fld.Desc.IsList():
+		return "repeated " + name
+	default:
+		return name
+	}
+}
+
+// resolverMethods returns the services and methods that resolve fields or fetch nodes, in the order that
+// they are declared in.
+func (tg *Target) resolverMethods() (svcs []*protogen.Service, mets []*protogen.Method) {
+	for _, svc := range tg.file.Services {
+		if _, ok := tg.resolvers.services[svc]; ok {
+			svcs = append(svcs, svc)
+		}
+
+		for _, met := range svc.Methods {
+			if _, ok := tg.resolvers.methods[met]; ok {
+				mets = append(mets, met)
+			}
+		}
+	}
+
+	return svcs, mets
+}
+
+// orderedResolvers returns the fields that are resolved, in the order that their methods are declared in
+// and then in the order of the method's resolves option.
+func (tg *Target) orderedResolvers() (ress []*Resolver) {
+	for _, svc := range tg.file.Services {
+		for _, met := range svc.Methods {
+			for _, q := range MethodOptions(met).GetResolves() {
+				if res, ok := tg.resolvers.mapped[q]; ok && res.Method == met {
+					ress = append(ress, res)
+				}
+			}
+		}
+	}
+
+	return ress
+}
@@ -0,0 +1,206 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	appsyncv1 "github.com/crewlinker/protoc-gen-appsync-go/proto/appsync/v1"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// pageInfoSource is recorded as the element that the shared PageInfo type is generated from
+var pageInfoSource = (&appsyncv1.Connection{}).ProtoReflect().Descriptor()
+
+// Connection holds the fields of a paginated rpc method that a Relay cursor connection is built from
+type Connection struct {
+	PageSize      *protogen.Field
+	PageToken     *protogen.Field
+	Items         *protogen.Field
+	NextPageToken *protogen.Field
+
+	// TypePrefix is put in front of the names of the connection, edge and page info types
+	TypePrefix string
+}
+
+// connection returns the connection of a method with the connection option, or nil if it has no such
+// option. It checks that the request and response have the fields that the connection is built from.
+func connection(met *protogen.Method) (conn *Connection, err error) {
+	opt := MethodOptions(met).GetConnection()
+	if opt == nil {
+		return nil, nil
+	}
+
+	conn = &Connection{TypePrefix: opt.GetTypePrefix()}
+	if conn.TypePrefix != "" && !graphName.MatchString(conn.TypePrefix) {
+		return nil, fmt.Errorf("invalid type prefix '%s'", conn.TypePrefix)
+	}
+
+	if conn.PageSize, err = connectionField(met.Input, opt.GetPageSize(), "page size"); err != nil {
+		return nil, err
+	} else if !isIntegerKind(conn.PageSize.Desc.Kind()) {
+		return nil, fmt.Errorf("page size field '%s' must be an integer field", opt.GetPageSize())
+	}
+
+	if conn.PageToken, err = connectionField(met.Input, opt.GetPageToken(), "page token"); err != nil {
+		return nil, err
+	} else if conn.PageToken.Desc.Kind() != protoreflect.StringKind {
+		return nil, fmt.Errorf("page token field '%s' must be a string field", opt.GetPageToken())
+	}
+
+	if conn.NextPageToken, err = connectionField(met.Output, opt.GetNextPageToken(), "next page token"); err != nil {
+		return nil, err
+	} else if conn.NextPageToken.Desc.Kind() != protoreflect.StringKind {
+		return nil, fmt.Errorf("next page token field '%s' must be a string field", opt.GetNextPageToken())
+	}
+
+	// without the items option, the response must have a single list of messages
+	if opt.Items == nil {
+		for _, fld := range met.Output.Fields {
+			if !fld.Desc.IsList() || fld.Desc.Kind() != protoreflect.MessageKind || FieldOptions(fld).GetIgnore() {
+				continue
+			}
+
+			if conn.Items != nil {
+				return nil, fmt.Errorf("response '%s' has more than one repeated message field, configure the items option",
+					met.Output.Desc.FullName())
+			}
+
+			conn.Items = fld
+		}
+
+		if conn.Items == nil {
+			return nil, fmt.Errorf("response '%s' has no repeated message field to take the items from",
+				met.Output.Desc.FullName())
+		}
+
+		return conn, nil
+	}
+
+	if conn.Items = messageField(met.Output, opt.GetItems()); conn.Items == nil {
+		return nil, fmt.Errorf("items field '%s' not found in '%s'", opt.GetItems(), met.Output.Desc.FullName())
+	} else if !conn.Items.Desc.IsList() || conn.Items.Desc.Kind() != protoreflect.MessageKind {
+		return nil, fmt.Errorf("items field '%s' must be a repeated message field", opt.GetItems())
+	}
+
+	return conn, nil
+}
+
+// connectionField returns the singular field of the message with the provided name, or an error that
+// describes what the field is for if there is no such field.
+func connectionField(msg *protogen.Message, name, what string) (*protogen.Field, error) {
+	fld := messageField(msg, name)
+	if fld == nil {
+		return nil, fmt.Errorf("%s field '%s' not found in '%s'", what, name, msg.Desc.FullName())
+	}
+
+	if fld.Desc.Cardinality() == protoreflect.Repeated {
+		return nil, fmt.Errorf("%s field '%s' must not be repeated", what, name)
+	}
+
+	return fld, nil
+}
+
+// isIntegerKind returns whether the kind is one of the protobuf integer kinds
+func isIntegerKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	default:
+		return false
+	}
+}
+
+// generateConnectionArguments replaces the page size and page token arguments of a field that resolves
+// a connection with the Relay pagination arguments. Page tokens only page forward, so just 'first' and
+// 'after' are declared.
+func (tg *Target) generateConnectionArguments(
+	args ast.ArgumentDefinitionList, conn *Connection,
+) (defs ast.ArgumentDefinitionList, err error) {
+	for _, arg := range args {
+		switch arg.Name {
+		case appsyncjson.FieldName(conn.PageSize.Desc, tg.gen.opts.ProtoNames),
+			appsyncjson.FieldName(conn.PageToken.Desc, tg.gen.opts.ProtoNames):
+			continue
+		case "first", "after":
+			return nil, fmt.Errorf("argument '%s' collides with the pagination arguments of the connection", arg.Name)
+		}
+
+		defs = append(defs, arg)
+	}
+
+	return append(defs,
+		&ast.ArgumentDefinition{Name: "first", Type: &ast.Type{NamedType: "Int"}},
+		&ast.ArgumentDefinition{Name: "after", Type: &ast.Type{NamedType: "String"}},
+	), nil
+}
+
+// generateConnection generates the connection and edge object types for the items of a connection. They
+// are shared by all connections of the same message with the same type prefix.
+func (tg *Target) generateConnection(conn *Connection) (def *ast.Definition, err error) {
+	node, err := tg.generateMessage(false, conn.Items.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate node definition: %w", err)
+	}
+
+	// the connection types are as accessible as the node they list
+	name := conn.TypePrefix + node.Name
+	def = &ast.Definition{Name: name + "Connection", Kind: ast.Object, Directives: copyDirectives(node.Directives)}
+	if claimed, err := tg.claimName(def.Name, conn.Items.Message.Desc); err != nil {
+		return nil, fmt.Errorf("%w, configure a type prefix for the connection", err)
+	} else if claimed {
+		return tg.sch.Types[def.Name], nil
+	}
+
+	edge := &ast.Definition{Name: name + "Edge", Kind: ast.Object, Directives: copyDirectives(node.Directives)}
+	if _, err := tg.claimName(edge.Name, conn.Items.Message.Desc); err != nil {
+		return nil, fmt.Errorf("%w, configure a type prefix for the connection", err)
+	}
+
+	info, err := tg.generatePageInfo(conn.TypePrefix, node.Directives)
+	if err != nil {
+		return nil, err
+	}
+
+	edge.Fields = ast.FieldList{
+		{Name: "node", Type: &ast.Type{NamedType: node.Name, NonNull: true}},
+		{Name: "cursor", Type: &ast.Type{NamedType: "String", NonNull: true}},
+	}
+
+	def.Fields = ast.FieldList{
+		{Name: "edges", Type: &ast.Type{Elem: &ast.Type{NamedType: edge.Name, NonNull: true}, NonNull: true}},
+		{Name: "pageInfo", Type: &ast.Type{NamedType: info.Name, NonNull: true}},
+	}
+
+	tg.sch.Types[edge.Name] = edge
+	tg.sch.Types[def.Name] = def
+	return def, nil
+}
+
+// generatePageInfo generates the PageInfo object type that all connections with the same type prefix
+// share. Since it is shared, it allows the authorization of every node that is listed by them.
+func (tg *Target) generatePageInfo(prefix string, dirs ast.DirectiveList) (def *ast.Definition, err error) {
+	def = &ast.Definition{Name: prefix + "PageInfo", Kind: ast.Object, Directives: copyDirectives(dirs)}
+	if claimed, err := tg.claimName(def.Name, pageInfoSource); err != nil {
+		return nil, err
+	} else if claimed {
+		def = tg.sch.Types[def.Name]
+		def.Directives = mergeAuthDirectives(def.Directives, dirs)
+		return def, nil
+	}
+
+	def.Fields = ast.FieldList{
+		{Name: "hasNextPage", Type: &ast.Type{NamedType: "Boolean", NonNull: true}},
+		{Name: "hasPreviousPage", Type: &ast.Type{NamedType: "Boolean", NonNull: true},
+			Description: "Always false, connections only page forward with the first and after arguments."},
+		{Name: "startCursor", Type: &ast.Type{NamedType: "String"}},
+		{Name: "endCursor", Type: &ast.Type{NamedType: "String"}},
+	}
+
+	tg.sch.Types[def.Name] = def
+	return def, nil
+}
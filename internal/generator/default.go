@@ -0,0 +1,37 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// generateDefault returns the graphql default value of a field with the default option, or nil if it has
+// none. The kind of value follows the graphql type of the field, i.e: an AWSTimestamp is an Int.
+func generateDefault(fld *protogen.Field, typ *ast.Type) (*ast.Value, error) {
+	v, err := appsyncjson.DefaultValue(fld.Desc)
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	if isNodeIDField(fld) {
+		return nil, fmt.Errorf("the id of a node can't have a default")
+	}
+
+	val := &ast.Value{Raw: FieldOptions(fld).GetDefault(), Kind: ast.StringValue}
+	switch {
+	case fld.Desc.Kind() == protoreflect.EnumKind:
+		val.Kind = ast.EnumValue
+	case typ.NamedType == "Boolean":
+		val.Kind = ast.BooleanValue
+	case typ.NamedType == "Int", typ.NamedType == "AWSTimestamp":
+		val.Kind = ast.IntValue
+	case typ.NamedType == "Float":
+		val.Kind = ast.FloatValue
+	}
+
+	return val, nil
+}
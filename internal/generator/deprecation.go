@@ -0,0 +1,66 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// fieldDeprecation returns the @deprecated directive for a field that is marked as deprecated, or nil
+func fieldDeprecation(fld *protogen.Field) *ast.Directive {
+	opts, _ := fld.Desc.Options().(*descriptorpb.FieldOptions)
+	return deprecation(opts.GetDeprecated(), FieldOptions(fld).GetDeprecationReason(), fld.Comments.Leading)
+}
+
+// methodDeprecation returns the @deprecated directive for the fields that a deprecated method resolves, or nil
+func methodDeprecation(met *protogen.Method) *ast.Directive {
+	opts, _ := met.Desc.Options().(*descriptorpb.MethodOptions)
+	return deprecation(opts.GetDeprecated(), MethodOptions(met).GetDeprecationReason(), met.Comments.Leading)
+}
+
+// enumValueDeprecation returns the @deprecated directive for an enum value that is marked as deprecated, or nil
+func enumValueDeprecation(val *protogen.EnumValue) *ast.Directive {
+	opts, _ := val.Desc.Options().(*descriptorpb.EnumValueOptions)
+	return deprecation(opts.GetDeprecated(), EnumValueOptions(val).GetDeprecationReason(), val.Comments.Leading)
+}
+
+// deprecation returns the @deprecated directive if 'isDeprecated' is true. The reason is taken from the
+// option, or else from a paragraph in the comments that starts with "Deprecated:". Without a reason the
+// directive's default reason applies.
+func deprecation(isDeprecated bool, reason string, comments protogen.Comments) *ast.Directive {
+	if !isDeprecated {
+		return nil
+	}
+
+	if reason == "" {
+		reason = deprecationComment(comments)
+	}
+
+	dir := &ast.Directive{Name: "deprecated"}
+	if reason != "" {
+		dir.Arguments = ast.ArgumentList{{Name: "reason", Value: &ast.Value{Kind: ast.StringValue, Raw: reason}}}
+	}
+
+	return dir
+}
+
+// deprecationComment returns the paragraph of the comments that starts with "Deprecated:", as is the
+// convention in Go.
+func deprecationComment(c protogen.Comments) string {
+	var para []string
+	for _, line := range strings.Split(string(c), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case para == nil && strings.HasPrefix(line, "Deprecated:"):
+			para = append(para, strings.TrimPrefix(line, "Deprecated:"))
+		case para != nil && line == "":
+			return strings.TrimSpace(strings.Join(para, " "))
+		case para != nil:
+			para = append(para, line)
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(para, " "))
+}
@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Diagnostic is a problem with an element of a proto file. It is located like protoc locates its own
+// errors, so that the output of protoc (or buf) points at the element: file.proto:line:column.
+type Diagnostic struct {
+	Path string
+
+	// Line and Column are one-based, they are zero if the file has no source info for the element
+	Line   int
+	Column int
+
+	Err error
+}
+
+// Error formats the diagnostic with its location
+func (d *Diagnostic) Error() string {
+	if d.Line < 1 {
+		return fmt.Sprintf("%s: %v", d.Path, d.Err)
+	}
+
+	return fmt.Sprintf("%s:%d:%d: %v", d.Path, d.Line, d.Column, d.Err)
+}
+
+// Unwrap returns the error that the diagnostic locates
+func (d *Diagnostic) Unwrap() error { return d.Err }
+
+// Diagnostics are all the problems that were found while generating from a file
+type Diagnostics []*Diagnostic
+
+// Error formats the diagnostics, one per line
+func (ds Diagnostics) Error() string {
+	lines := make([]string, len(ds))
+	for i, d := range ds {
+		lines[i] = d.Error()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// diagnose locates an error at the proto element that it is about. Errors that were already located, at a
+// more specific element, keep that location and drop the context that was added to them since.
+func diagnose(desc protoreflect.Descriptor, err error) *Diagnostic {
+	var d *Diagnostic
+	if errors.As(err, &d) {
+		return d
+	}
+
+	d = &Diagnostic{Path: desc.ParentFile().Path(), Err: err}
+	if _, ok := desc.(protoreflect.FileDescriptor); ok {
+		return d // the problem is with the file as a whole
+	}
+
+	if loc := desc.ParentFile().SourceLocations().ByDescriptor(desc); loc.Path != nil {
+		d.Line, d.Column = loc.StartLine+1, loc.StartColumn+1
+	}
+
+	return d
+}
+
+// report records a problem with a proto element, so that generation can continue and all problems of the
+// file are reported together. A problem that is run into more than once, i.e. through every field that
+// refers to the element, is reported once.
+func (tg *Target) report(desc protoreflect.Descriptor, err error) {
+	d := diagnose(desc, err)
+	for _, other := range tg.diags {
+		if other.Error() == d.Error() {
+			return
+		}
+	}
+
+	tg.diags = append(tg.diags, d)
+}
+
+// diagnostics returns the reported problems, ordered by their location in the proto files
+func (tg *Target) diagnostics() Diagnostics {
+	sort.SliceStable(tg.diags, func(i, j int) bool {
+		a, b := tg.diags[i], tg.diags[j]
+		switch {
+		case a.Path != b.Path:
+			return a.Path < b.Path
+		case a.Line != b.Line:
+			return a.Line < b.Line
+		default:
+			return a.Column < b.Column
+		}
+	})
+
+	return tg.diags
+}
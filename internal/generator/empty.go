@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EmptyMessageMode determines how fields of empty messages are exposed on the output side of the schema.
+// Graphql doesn't allow object types without fields. On the input side such fields are always omitted.
+type EmptyMessageMode string
+
+const (
+	// EmptyMessageField exposes empty messages as an object type with a single nullable "_empty" field
+	EmptyMessageField EmptyMessageMode = "field"
+	// EmptyMessageBoolean exposes fields of empty messages as a nullable Boolean that is true when set
+	EmptyMessageBoolean EmptyMessageMode = "boolean"
+)
+
+// emptyFieldName is the name of the placeholder field of empty object types
+const emptyFieldName = "_empty"
+
+// isEmpty returns whether the message has no fields in the graphql schema. On the input side fields of
+// empty messages are omitted, so a message with only such fields is empty as well.
+func isEmpty(isInput bool, msg *protogen.Message) bool {
+	return isEmptyMessage(isInput, msg, map[*protogen.Message]bool{})
+}
+
+// isEmptyMessage implements isEmpty, 'path' holds the messages we're recursing through
+func isEmptyMessage(isInput bool, msg *protogen.Message, path map[*protogen.Message]bool) bool {
+	if path[msg] {
+		return true // a message can't be non-empty just because it holds itself
+	}
+
+	path[msg] = true
+	defer delete(path, msg)
+
+	for _, fld := range msg.Fields {
+		if fopts := FieldOptions(fld); fopts != nil && fopts.Ignore != nil && *fopts.Ignore {
+			continue
+		}
+
+		if isInput && isEmptyField(fld, path) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// isEmptyField returns whether the field holds an empty message that is omitted from input
+func isEmptyField(fld *protogen.Field, path map[*protogen.Message]bool) bool {
+	if fld.Desc.Kind() != protoreflect.MessageKind || fld.Desc.IsMap() || isWellKnown(fld.Message) {
+		return false
+	}
+
+	if FieldOptions(fld).GetJson() {
+		return false // exposed as a scalar
+	}
+
+	return isEmptyMessage(true, fld.Message, path)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/vektah/gqlparser/v2/ast"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 //go:embed *.gotmpl
@@ -26,10 +27,36 @@ type Options struct {
 	QueryMessageName        string
 	MutationMessageName     string
 	SubscriptionMessageName string
+
+	// RenameRoots names the root types Query, Mutation and Subscription, whatever their messages are named
+	RenameRoots bool
+
+	// ValidateScalars makes the resolvers check arguments against the format of their (AWS) scalar type
+	ValidateScalars bool
+
+	// ProtoNames names graphql fields after the fields in the proto file instead of their json names
+	ProtoNames bool
+
+	// EmptyMessages determines how fields of empty messages are exposed, defaults to EmptyMessageField
+	EmptyMessages EmptyMessageMode
+
+	// DefaultAuth is the authorization mode of object types that have no authorization configured. If
+	// it is empty, such types get no authorization directives.
+	DefaultAuth AuthMode
 }
 
 // New inits the generator
 func New(logs *zap.Logger, opts *Options) (g *Generator, err error) {
+	switch opts.EmptyMessages {
+	case "", EmptyMessageField, EmptyMessageBoolean:
+	default:
+		return nil, fmt.Errorf("unsupported empty message mode: '%s'", opts.EmptyMessages)
+	}
+
+	if _, ok := authDirectives[opts.DefaultAuth]; !ok && opts.DefaultAuth != "" {
+		return nil, fmt.Errorf("unsupported default auth mode: '%s'", opts.DefaultAuth)
+	}
+
 	g = &Generator{
 		logs: logs.Named("generator"),
 		tmpl: template.New("root"),
@@ -53,13 +80,21 @@ func (g *Generator) NewTarget(pf *protogen.File) *Target {
 	tg := &Target{
 		gen:  g,
 		file: pf,
-		sch:  &ast.Schema{Types: make(map[string]*ast.Definition)},
+		sch: &ast.Schema{
+			Types:      make(map[string]*ast.Definition),
+			Directives: make(map[string]*ast.DirectiveDefinition),
+		},
+		names: make(map[string]protoreflect.Descriptor),
+		auths: make(map[protoreflect.Descriptor]bool),
 	}
 
-	tg.resolvers.mapped = make(map[string]*protogen.Method)
+	tg.resolvers.mapped = make(map[string]*Resolver)
 	tg.resolvers.unmapped = make(map[string]*protogen.Method)
+	tg.resolvers.inputs = make(map[string]*protogen.Field)
 	tg.resolvers.services = make(map[*protogen.Service]struct{})
 	tg.resolvers.methods = make(map[*protogen.Method]struct{})
+	tg.resolvers.connections = make(map[*protogen.Method]*Connection)
+	tg.resolvers.results = make(map[*protogen.Method]*protogen.Field)
 
 	return tg
 }
@@ -0,0 +1,124 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateInterface turns the definition of a message with the interface option into an interface. Its
+// fields, other than the oneof, declare the fields of the interface. The messages of the oneof members
+// implement it.
+func (tg *Target) generateInterface(def *ast.Definition, msg *protogen.Message) (err error) {
+	def.Kind = ast.Interface
+
+	var impls *protogen.Oneof
+	for _, fld := range msg.Fields {
+		if fld.Oneof != nil && !fld.Oneof.Desc.IsSynthetic() {
+			impls = fld.Oneof
+			continue
+		}
+
+		if FieldOptions(fld).GetIgnore() {
+			continue
+		}
+
+		fdef, err := tg.generateField(false, fld)
+		if err != nil {
+			tg.report(fld.Desc, err)
+			continue
+		} else if fdef == nil {
+			continue
+		}
+
+		def.Fields = append(def.Fields, fdef)
+	}
+
+	if len(def.Fields) < 1 {
+		return fmt.Errorf("interface '%s' must declare at least one field", msg.Desc.FullName())
+	}
+
+	if impls == nil {
+		return fmt.Errorf("interface '%s' must have a oneof with the messages that implement it", msg.Desc.FullName())
+	}
+
+	for _, fld := range impls.Fields {
+		if fld.Message == nil || isWellKnown(fld.Message) {
+			return fmt.Errorf("member '%s' of interface '%s' must be a message field", fld.Desc.Name(), msg.Desc.FullName())
+		}
+
+		impl, err := tg.generateMessage(false, fld.Message)
+		if err != nil {
+			tg.report(fld.Desc, fmt.Errorf("failed to generate implementation '%s': %w", fld.Message.Desc.FullName(), err))
+			continue
+		} else if impl.Kind != ast.Object {
+			return fmt.Errorf("member '%s' of interface '%s' must be an object type", fld.Desc.Name(), msg.Desc.FullName())
+		}
+
+		if !contains(impl.Interfaces, def.Name) {
+			impl.Interfaces = append(impl.Interfaces, def.Name)
+		}
+	}
+
+	return nil
+}
+
+// checkInterfaces checks that the types implementing an interface have all of its fields, with types that
+// are compatible: https://spec.graphql.org/October2021/#IsValidImplementation(). Implementations that
+// aren't are reported at the message that they are generated from.
+func (tg *Target) checkInterfaces() {
+	names := make([]string, 0, len(tg.sch.Types))
+	for name := range tg.sch.Types {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		impl := tg.sch.Types[name]
+		for _, ifaceName := range impl.Interfaces {
+			iface := tg.sch.Types[ifaceName]
+			for _, ifld := range iface.Fields {
+				fld := impl.Fields.ForName(ifld.Name)
+				if fld == nil {
+					tg.report(tg.names[name], fmt.Errorf("invalid interface: '%s' implements '%s' but has no field '%s'",
+						impl.Name, iface.Name, ifld.Name))
+				} else if !tg.isSubType(fld.Type, ifld.Type) {
+					tg.report(tg.names[name], fmt.Errorf("invalid interface: field '%s' of '%s' has type '%s', "+
+						"which is not compatible with type '%s' of interface '%s'",
+						fld.Name, impl.Name, fld.Type, ifld.Type, iface.Name))
+				}
+			}
+		}
+	}
+}
+
+// isSubType returns whether a field of type 'typ' can implement an interface field of type 'of'. It may be
+// stricter about null, and may be an object type that implements the interface type of the field.
+func (tg *Target) isSubType(typ, of *ast.Type) bool {
+	switch {
+	case of.NonNull && !typ.NonNull:
+		return false
+	case (typ.Elem == nil) != (of.Elem == nil):
+		return false
+	case typ.Elem != nil:
+		return tg.isSubType(typ.Elem, of.Elem)
+	case typ.NamedType == of.NamedType:
+		return true
+	default:
+		def := tg.sch.Types[typ.NamedType]
+		return def != nil && contains(def.Interfaces, of.NamedType)
+	}
+}
+
+// contains returns whether the list of names contains the name
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
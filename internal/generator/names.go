@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// graphName matches valid graphql names: https://spec.graphql.org/October2021/#Name
+var graphName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// messageName returns the name of the graphql type for a message. It defaults to the name of the Go type
+// but can be overridden with the "name" message option. With the RenameRoots option the root messages
+// are always named Query, Mutation and Subscription.
+func (tg *Target) messageName(msg *protogen.Message) (string, error) {
+	if op := tg.rootOperation(msg); op != "" && tg.gen.opts.RenameRoots {
+		return rootNames[op], nil
+	}
+
+	if opt := MessageOptions(msg).GetName(); opt != "" && !graphName.MatchString(opt) {
+		return "", fmt.Errorf("invalid name option '%s'", opt)
+	}
+
+	return appsyncjson.TypeName(msg.Desc), nil
+}
+
+// rootNames holds the default names of the root types, as assumed when the schema has no schema block
+var rootNames = map[ast.Operation]string{
+	ast.Query:        "Query",
+	ast.Mutation:     "Mutation",
+	ast.Subscription: "Subscription",
+}
+
+// rootOperation returns the operation that the message is the root type for, or an empty string if the
+// message is not one of the root messages.
+func (tg *Target) rootOperation(msg *protogen.Message) ast.Operation {
+	switch {
+	case tg.isRootMessage(msg, tg.gen.opts.QueryMessageName):
+		return ast.Query
+	case tg.isRootMessage(msg, tg.gen.opts.MutationMessageName):
+		return ast.Mutation
+	case tg.isRootMessage(msg, tg.gen.opts.SubscriptionMessageName):
+		return ast.Subscription
+	default:
+		return ""
+	}
+}
+
+// enumName returns the name of the graphql type for an enum. It defaults to the name of the Go type but
+// can be overridden with the "name" enum option.
+func enumName(enum *protogen.Enum) (string, error) {
+	if opt := EnumOptions(enum).GetName(); opt != "" && !graphName.MatchString(opt) {
+		return "", fmt.Errorf("invalid name option '%s'", opt)
+	}
+
+	return appsyncjson.TypeName(enum.Desc), nil
+}
+
+// isRootMessage returns whether the message is the top-level message in the file with the provided name
+func (tg *Target) isRootMessage(msg *protogen.Message, name string) bool {
+	return msg.Desc.FullName() == tg.file.Desc.Package().Append(protoreflect.Name(name))
+}
+
+// fieldName returns the name of the graphql field for a message field
+func fieldName(fld *protogen.Field, protoNames bool) (string, error) {
+	if opt := FieldOptions(fld).GetName(); opt != "" && !graphName.MatchString(opt) {
+		return "", fmt.Errorf("invalid name option '%s'", opt)
+	}
+
+	return appsyncjson.FieldName(fld.Desc, protoNames), nil
+}
+
+// claimName records that the graphql type 'name' is generated from the protobuf element 'src'. It returns
+// true if the type was already generated from the same element, and an error if another element already
+// claimed the name.
+func (tg *Target) claimName(name string, src protoreflect.Descriptor) (bool, error) {
+	if other, ok := tg.names[name]; ok {
+		if other.FullName() != src.FullName() {
+			err := fmt.Errorf("graphql type '%s' for '%s' collides with the type for '%s'",
+				name, src.FullName(), other.FullName())
+			if other == pageInfoSource || src == pageInfoSource {
+				err = fmt.Errorf("%w, configure a type prefix for the connection", err)
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	tg.names[name] = src
+	tg.order = append(tg.order, name)
+	return false, nil
+}
+
+// description returns the graphql description for the (leading) comments of a protobuf element
+func description(c protogen.Comments) string {
+	lines := strings.Split(strings.TrimRight(string(c), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(strings.TrimPrefix(line, " "), " \t")
+	}
+
+	return blockString(strings.TrimSpace(strings.Join(lines, "\n")))
+}
+
+// appendDescription adds a paragraph to a description that was returned by description
+func appendDescription(desc, paragraph string) string {
+	if desc = strings.TrimSuffix(desc, " "); desc != "" {
+		desc += "\n\n"
+	}
+
+	return desc + blockString(paragraph)
+}
+
+// blockString escapes a description, since descriptions are written as block strings
+func blockString(desc string) string {
+	desc = strings.ReplaceAll(desc, `"""`, `\"""`)
+	if strings.HasSuffix(desc, `"`) {
+		desc += " " // the closing quotes of the block string must not run into the description
+	}
+
+	return desc
+}
@@ -0,0 +1,151 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	appsyncv1 "github.com/crewlinker/protoc-gen-appsync-go/proto/appsync/v1"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// nodeInterfaceSource is recorded as the element that the Node interface is generated from
+var nodeInterfaceSource = (&appsyncv1.Node{}).ProtoReflect().Descriptor()
+
+// Node holds a message that is a Relay node, and the rpc method that fetches it by its id
+type Node struct {
+	Message  *protogen.Message
+	TypeName string
+
+	// Fetch is the method that fetches the node, FetchID the field of its request that holds the id
+	Fetch   *protogen.Method
+	FetchID *protogen.Field
+
+	// Result is the field of the fetch response that holds the node, nil if the response is the node
+	Result *protogen.Field
+}
+
+// generateNode makes the object type of a message with the node option implement the Node interface, and
+// records how it is fetched for the "node" query field.
+func (tg *Target) generateNode(def *ast.Definition, msg *protogen.Message) (err error) {
+	opt := MessageOptions(msg).GetNode()
+	node := &Node{Message: msg, TypeName: def.Name}
+
+	if id := messageField(msg, opt.GetId()); id == nil {
+		return fmt.Errorf("id field '%s' not found in '%s'", opt.GetId(), msg.Desc.FullName())
+	} else if !isNodeIDField(id) {
+		return fmt.Errorf("id field '%s' must be a string field", opt.GetId())
+	}
+
+	svcName, metName, ok := strings.Cut(opt.GetFetch(), ".")
+	for _, svc := range tg.file.Services {
+		for _, met := range svc.Methods {
+			if ok && string(svc.Desc.Name()) == svcName && string(met.Desc.Name()) == metName {
+				node.Fetch = met
+			}
+		}
+	}
+
+	if node.Fetch == nil {
+		return fmt.Errorf("fetch method '%s' not found", opt.GetFetch())
+	}
+
+	if node.FetchID = messageField(node.Fetch.Input, opt.GetId()); node.FetchID == nil ||
+		node.FetchID.Desc.Kind() != protoreflect.StringKind || node.FetchID.Desc.Cardinality() == protoreflect.Repeated {
+		return fmt.Errorf("request of fetch method '%s' has no string field '%s'", opt.GetFetch(), opt.GetId())
+	} else if node.FetchID.Oneof != nil {
+		// the id is assigned to the request as a plain string, which optional fields and oneofs don't hold
+		return fmt.Errorf("id field '%s' of the request of fetch method '%s' must not be optional or part of a oneof",
+			opt.GetId(), opt.GetFetch())
+	}
+
+	// the response is the node itself, or holds it in one of its fields
+	if node.Fetch.Output.Desc.FullName() != msg.Desc.FullName() {
+		for _, fld := range node.Fetch.Output.Fields {
+			if fld.Message != nil && fld.Message.Desc.FullName() == msg.Desc.FullName() &&
+				fld.Desc.Cardinality() != protoreflect.Repeated {
+				node.Result = fld
+				break
+			}
+		}
+
+		if node.Result == nil {
+			return fmt.Errorf("response of fetch method '%s' is not '%s' and has no field of its type",
+				opt.GetFetch(), msg.Desc.FullName())
+		}
+	}
+
+	iface, err := tg.generateNodeInterface()
+	if err != nil {
+		return err
+	}
+
+	def.Interfaces = append(def.Interfaces, iface.Name)
+	tg.nodes = append(tg.nodes, node)
+	tg.resolvers.methods[node.Fetch] = struct{}{}
+	tg.resolvers.services[node.Fetch.Parent] = struct{}{}
+	return nil
+}
+
+// generateNodeInterface generates the Node interface that all Relay nodes implement
+func (tg *Target) generateNodeInterface() (def *ast.Definition, err error) {
+	def = &ast.Definition{Name: "Node", Kind: ast.Interface}
+	if claimed, err := tg.claimName(def.Name, nodeInterfaceSource); err != nil {
+		return nil, err
+	} else if claimed {
+		return tg.sch.Types[def.Name], nil
+	}
+
+	def.Fields = ast.FieldList{{Name: appsyncjson.NodeIDFieldName, Type: ast.NonNullNamedType("ID", nil)}}
+	tg.sch.Types[def.Name] = def
+	return def, nil
+}
+
+// generateNodeField adds the "node" field to the query type if there are Relay nodes. The nodes must be
+// fetched by methods of a single service, since the field is resolved by a single resolver.
+func (tg *Target) generateNodeField() error {
+	if len(tg.nodes) < 1 {
+		return nil
+	}
+
+	if tg.sch.Query == nil {
+		return fmt.Errorf("nodes require the query message '%s'", tg.gen.opts.QueryMessageName)
+	}
+
+	if tg.sch.Query.Fields.ForName("node") != nil {
+		return diagnose(tg.names[tg.sch.Query.Name], fmt.Errorf("the 'node' field of the query type is reserved for fetching nodes"))
+	}
+
+	sort.Slice(tg.nodes, func(i, j int) bool { return tg.nodes[i].TypeName < tg.nodes[j].TypeName })
+	for _, node := range tg.nodes {
+		if tg.nodeService != nil && node.Fetch.Parent != tg.nodeService {
+			return diagnose(node.Fetch.Desc, fmt.Errorf("nodes must be fetched by methods of the same service, got '%s' and '%s'",
+				tg.nodeService.Desc.FullName(), node.Fetch.Parent.Desc.FullName()))
+		}
+
+		tg.nodeService = node.Fetch.Parent
+	}
+
+	tg.sch.Query.Fields = append(tg.sch.Query.Fields, &ast.FieldDefinition{
+		Name:        "node",
+		Description: "fetches an object by its globally unique id",
+		Arguments: ast.ArgumentDefinitionList{
+			{Name: appsyncjson.NodeIDFieldName, Type: ast.NonNullNamedType("ID", nil)},
+		},
+		Type: ast.NamedType("Node", nil),
+	})
+
+	tg.nodeQualifier = tg.sch.Query.Name + ".node"
+	return nil
+}
+
+// isNodeIDField returns whether the field is the (string) id field of a message that is a Relay node. It
+// is exposed as the id of the Node interface.
+func isNodeIDField(fld *protogen.Field) bool {
+	opt := MessageOptions(fld.Parent).GetNode()
+	return opt != nil && string(fld.Desc.Name()) == opt.GetId() &&
+		fld.Desc.Kind() == protoreflect.StringKind && fld.Desc.Cardinality() != protoreflect.Repeated
+}
@@ -40,3 +40,54 @@ func MethodOptions(f *protogen.Method) *appsyncv1.MethodOptions {
 	}
 	return ext
 }
+
+// EnumOptions returns our plugin specific options for an enum. If the enum has no options
+// it returns nil.
+func EnumOptions(e *protogen.Enum) *appsyncv1.EnumOptions {
+	opts, ok := e.Desc.Options().(*descriptorpb.EnumOptions)
+	if !ok {
+		return nil
+	}
+	ext, ok := proto.GetExtension(opts, appsyncv1.E_Enum).(*appsyncv1.EnumOptions)
+	if !ok {
+		return nil
+	}
+	if ext == nil {
+		return nil
+	}
+	return ext
+}
+
+// MessageOptions returns our plugin specific options for a message. If the message has no options
+// it returns nil.
+func MessageOptions(m *protogen.Message) *appsyncv1.MessageOptions {
+	opts, ok := m.Desc.Options().(*descriptorpb.MessageOptions)
+	if !ok {
+		return nil
+	}
+	ext, ok := proto.GetExtension(opts, appsyncv1.E_Message).(*appsyncv1.MessageOptions)
+	if !ok {
+		return nil
+	}
+	if ext == nil {
+		return nil
+	}
+	return ext
+}
+
+// EnumValueOptions returns our plugin specific options for an enum value. If the value has no options
+// it returns nil.
+func EnumValueOptions(v *protogen.EnumValue) *appsyncv1.EnumValueOptions {
+	opts, ok := v.Desc.Options().(*descriptorpb.EnumValueOptions)
+	if !ok {
+		return nil
+	}
+	ext, ok := proto.GetExtension(opts, appsyncv1.E_EnumValue).(*appsyncv1.EnumValueOptions)
+	if !ok {
+		return nil
+	}
+	if ext == nil {
+		return nil
+	}
+	return ext
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// declaredBefore returns whether protobuf element 'a' is declared before element 'b'. Elements of the
+// file that is generated from come first, then those of other files ordered by their path. Within a file
+// their position in the source decides, or their position in the descriptor if the file has no source info.
+func (tg *Target) declaredBefore(a, b protoreflect.Descriptor) bool {
+	if af, bf := a.ParentFile().Path(), b.ParentFile().Path(); af != bf {
+		if af == tg.file.Desc.Path() || bf == tg.file.Desc.Path() {
+			return af == tg.file.Desc.Path()
+		}
+
+		return af < bf
+	}
+
+	aloc := a.ParentFile().SourceLocations().ByDescriptor(a)
+	bloc := b.ParentFile().SourceLocations().ByDescriptor(b)
+	if aloc.Path != nil && bloc.Path != nil {
+		if aloc.StartLine != bloc.StartLine {
+			return aloc.StartLine < bloc.StartLine
+		}
+
+		return aloc.StartColumn < bloc.StartColumn
+	}
+
+	apath, bpath := declarationPath(a), declarationPath(b)
+	for i := 0; i < len(apath) && i < len(bpath); i++ {
+		if apath[i] != bpath[i] {
+			return apath[i] < bpath[i]
+		}
+	}
+
+	return len(apath) < len(bpath)
+}
+
+// declarationPath returns the path of an element in the descriptor of its file, as source info locates
+// elements by: the number of the descriptor field that holds the element, followed by its index.
+func declarationPath(desc protoreflect.Descriptor) []int {
+	if _, ok := desc.(protoreflect.FileDescriptor); ok {
+		return nil
+	}
+
+	_, topLevel := desc.Parent().(protoreflect.FileDescriptor)
+
+	var num int
+	switch d := desc.(type) {
+	case protoreflect.MessageDescriptor:
+		num = 3 // nested_type
+		if topLevel {
+			num = 4 // message_type
+		}
+	case protoreflect.EnumDescriptor:
+		num = 4 // enum_type
+		if topLevel {
+			num = 5
+		}
+	case protoreflect.FieldDescriptor:
+		num = 2 // field
+		if d.IsExtension() {
+			num = 6 // extension
+			if topLevel {
+				num = 7
+			}
+		}
+	case protoreflect.OneofDescriptor:
+		num = 8 // oneof_decl
+	case protoreflect.ServiceDescriptor:
+		num = 6 // service
+	case protoreflect.EnumValueDescriptor, protoreflect.MethodDescriptor:
+		num = 2 // value, method
+	}
+
+	return append(declarationPath(desc.Parent()), num, desc.Index())
+}
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+//go:embed appsync.graphql
+var appsyncPreludeGraphql string
+
+// appsyncPrelude declares the scalars and directives that AppSync provides to every schema
+var appsyncPrelude = &ast.Source{Name: "appsync.graphql", Input: appsyncPreludeGraphql, BuiltIn: true}
+
+// validateSchema loads the generated schema text together with the AppSync prelude, to catch what AppSync
+// would reject when the schema is deployed. The problem is reported at the protobuf element that the
+// graphql element it is about was generated from, and names the graphql element.
+func (tg *Target) validateSchema(text string) {
+	src := &ast.Source{Name: tg.file.Desc.Path(), Input: text}
+	_, err := gqlparser.LoadSchema(appsyncPrelude, src)
+	if err == nil {
+		return
+	}
+
+	var gerr *gqlerror.Error
+	if !errors.As(err, &gerr) || len(gerr.Locations) < 1 {
+		tg.report(tg.file.Desc, fmt.Errorf("invalid graphql schema: %w", err))
+		return
+	}
+
+	typ, fld := schemaElement(src, gerr.Locations[0].Line)
+	switch from, ok := tg.names[typ]; {
+	case typ == "":
+		tg.report(tg.file.Desc, fmt.Errorf("invalid graphql schema: %s", gerr.Message))
+	case fld != "" && ok:
+		tg.report(tg.fieldSource(from, fld), fmt.Errorf("invalid graphql schema at '%s.%s' (generated from '%s'): %s",
+			typ, fld, from.FullName(), gerr.Message))
+	case fld != "":
+		tg.report(tg.file.Desc, fmt.Errorf("invalid graphql schema at '%s.%s': %s", typ, fld, gerr.Message))
+	case ok:
+		tg.report(from, fmt.Errorf("invalid graphql schema at '%s' (generated from '%s'): %s",
+			typ, from.FullName(), gerr.Message))
+	default:
+		tg.report(tg.file.Desc, fmt.Errorf("invalid graphql schema at '%s': %s", typ, gerr.Message))
+	}
+}
+
+// fieldSource returns the field of a message that a graphql field is generated from, or the message itself
+// if it has no such field.
+func (tg *Target) fieldSource(from protoreflect.Descriptor, name string) protoreflect.Descriptor {
+	md, ok := from.(protoreflect.MessageDescriptor)
+	if !ok {
+		return from
+	}
+
+	for i := 0; i < md.Fields().Len(); i++ {
+		if fd := md.Fields().Get(i); appsyncjson.FieldName(fd, tg.gen.opts.ProtoNames) == name {
+			return fd
+		}
+	}
+
+	return from
+}
+
+// schemaElement returns the name of the type or directive, and possibly its field, that is defined at the
+// line of the schema text. The name is empty if nothing is defined there, i.e. for the schema block.
+func schemaElement(src *ast.Source, line int) (typ, fld string) {
+	doc, err := parser.ParseSchema(src)
+	if err != nil {
+		return "", ""
+	}
+
+	var start int
+	for _, def := range append(doc.Definitions, doc.Extensions...) {
+		if def.Position == nil || def.Position.Line > line || def.Position.Line < start {
+			continue
+		}
+
+		typ, fld, start = def.Name, "", def.Position.Line
+		for _, fdef := range def.Fields {
+			if fdef.Position != nil && fdef.Position.Line <= line {
+				fld = fdef.Name
+			}
+		}
+
+		for _, vdef := range def.EnumValues {
+			if vdef.Position != nil && vdef.Position.Line <= line {
+				fld = vdef.Name
+			}
+		}
+	}
+
+	for _, dir := range doc.Directives {
+		if dir.Position != nil && dir.Position.Line <= line && dir.Position.Line >= start {
+			typ, fld, start = "@"+dir.Name, "", dir.Position.Line
+		}
+	}
+
+	return typ, fld
+}
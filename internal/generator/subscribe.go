@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"fmt"
+
+	appsyncv1 "github.com/crewlinker/protoc-gen-appsync-go/proto/appsync/v1"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// generateSubscribe generates the @aws_subscribe directive and the arguments for a field of the subscription
+// message. It checks that the mutations exist and are of the same type as the subscription field.
+func (tg *Target) generateSubscribe(
+	fld *protogen.Field, sub *appsyncv1.Subscribe,
+) (dir *ast.Directive, args ast.ArgumentDefinitionList, err error) {
+	if !tg.isRootMessage(fld.Parent, tg.gen.opts.SubscriptionMessageName) {
+		return nil, nil, fmt.Errorf("only fields of the subscription message '%s' can subscribe to mutations",
+			tg.gen.opts.SubscriptionMessageName)
+	}
+
+	if len(sub.Mutations) < 1 {
+		return nil, nil, fmt.Errorf("subscribe option must list at least one mutation")
+	}
+
+	var mutation *protogen.Message
+	for _, msg := range tg.file.Messages {
+		if tg.isRootMessage(msg, tg.gen.opts.MutationMessageName) {
+			mutation = msg
+		}
+	}
+
+	if mutation == nil {
+		return nil, nil, fmt.Errorf("mutation message '%s' not found", tg.gen.opts.MutationMessageName)
+	}
+
+	mutations := &ast.Value{Kind: ast.ListValue}
+	for _, name := range sub.Mutations {
+		mfld := messageField(mutation, name)
+		if mfld == nil {
+			return nil, nil, fmt.Errorf("mutation field '%s' not found", name)
+		}
+
+		if !sameType(fld, mfld) {
+			return nil, nil, fmt.Errorf("mutation field '%s' is not of the same type as the subscription field", name)
+		}
+
+		mname, err := fieldName(mfld, tg.gen.opts.ProtoNames)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		mutations.Children = append(mutations.Children, &ast.ChildValue{
+			Value: &ast.Value{Kind: ast.StringValue, Raw: mname},
+		})
+	}
+
+	// arguments are fields of the subscription's message, AppSync filters on them
+	for _, name := range sub.Arguments {
+		if fld.Message == nil {
+			return nil, nil, fmt.Errorf("subscription arguments require the field to be a message")
+		}
+
+		afld := messageField(fld.Message, name)
+		if afld == nil {
+			return nil, nil, fmt.Errorf("argument field '%s' not found in '%s'", name, fld.Message.Desc.FullName())
+		}
+
+		if afld.Desc.Kind() == protoreflect.MessageKind || afld.Desc.IsList() {
+			return nil, nil, fmt.Errorf("argument field '%s' must be a scalar or enum field", name)
+		}
+
+		adef, err := tg.generateField(true, afld)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate argument '%s': %w", name, err)
+		}
+
+		args = append(args, &ast.ArgumentDefinition{
+			Name:        adef.Name,
+			Type:        ast.NamedType(adef.Type.NamedType, nil), // subscribers don't have to filter
+			Description: adef.Description,
+		})
+	}
+
+	return &ast.Directive{
+		Name:      "aws_subscribe",
+		Arguments: ast.ArgumentList{{Name: "mutations", Value: mutations}},
+	}, args, nil
+}
+
+// messageField returns the field of the message with the provided (proto) name, nil if there is no such
+// field or if it is ignored.
+func messageField(msg *protogen.Message, name string) *protogen.Field {
+	for _, fld := range msg.Fields {
+		if string(fld.Desc.Name()) == name && !FieldOptions(fld).GetIgnore() {
+			return fld
+		}
+	}
+
+	return nil
+}
+
+// sameType returns whether two fields end up with the same type in the graphql schema, disregarding
+// whether they are nullable.
+func sameType(a, b *protogen.Field) bool {
+	switch {
+	case a.Desc.Kind() != b.Desc.Kind(), a.Desc.IsList() != b.Desc.IsList(), a.Desc.IsMap() != b.Desc.IsMap():
+		return false
+	case a.Message != nil && a.Message.Desc.FullName() != b.Message.Desc.FullName():
+		return false
+	case a.Enum != nil && a.Enum.Desc.FullName() != b.Enum.Desc.FullName():
+		return false
+	default:
+		return FieldOptions(a).GetType() == FieldOptions(b).GetType() && FieldOptions(a).GetJson() == FieldOptions(b).GetJson()
+	}
+}
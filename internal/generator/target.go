@@ -1,10 +1,12 @@
 package generator
 
 import (
+	"bytes"
 	"fmt"
 	"io"
-	"strings"
+	"sort"
 
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/formatter"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -18,68 +20,103 @@ type Target struct {
 
 	file *protogen.File
 
+	// names of the graphql types mapped to the protobuf element they are generated from, and the order in
+	// which they were generated
+	names map[string]protoreflect.Descriptor
+	order []string
+
+	// problems that were found while generating, they are all reported together
+	diags Diagnostics
+
+	// elements with an auth option, mapped to whether they are generated as part of an object type
+	auths map[protoreflect.Descriptor]bool
+
+	// relay nodes, the service with the methods that fetch them and the field that resolves them
+	nodes         []*Node
+	nodeService   *protogen.Service
+	nodeQualifier string
+
 	resolvers struct {
-		unmapped map[string]*protogen.Method
-		mapped   map[string]*protogen.Method
-		methods  map[*protogen.Method]struct{}
-		services map[*protogen.Service]struct{}
+		unmapped    map[string]*protogen.Method
+		inputs      map[string]*protogen.Field
+		mapped      map[string]*Resolver
+		connections map[*protogen.Method]*Connection
+		results     map[*protogen.Method]*protogen.Field
+		methods     map[*protogen.Method]struct{}
+		services    map[*protogen.Service]struct{}
 	}
 }
 
 // TargetData is exposed to our templates
 type TargetData struct {
 	*protogen.File
-	Resolvers        map[string]*protogen.Method
-	ResolverMethods  map[*protogen.Method]struct{}
-	ResolverServices map[*protogen.Service]struct{}
+	Resolve          *protogen.GeneratedFile
+	Options          Options
+	Resolvers        []*Resolver
+	ResolverMethods  []*protogen.Method
+	ResolverServices []*protogen.Service
+	Connections      map[*protogen.Method]*Connection
+	ResultFields     map[*protogen.Method]*protogen.Field
+	Nodes            []*Node
+	NodeService      *protogen.Service
+	NodeQualifier    string
 }
 
-// Generate the target and write an graph schema and resolver code.
-func (tg *Target) Generate(graphw, resolvew io.Writer) error {
+// Ident returns the Go identifier 'name' of the package at 'importPath', qualified for use in the resolving
+// code. The package is imported by the generated file if it isn't already.
+func (d TargetData) Ident(importPath, name string) string {
+	return d.Resolve.QualifiedGoIdent(protogen.GoImportPath(importPath).Ident(name))
+}
+
+// Generate the target and write an graph schema and resolver code. The resolver code is written to a
+// generated file so that message types from other Go packages are imported. Problems with the proto file
+// are returned as Diagnostics, that hold all of them.
+func (tg *Target) Generate(graphw io.Writer, resolvef *protogen.GeneratedFile) error {
 
 	// index service methods and if they are marked to resolve a field
-	for _, svc := range tg.file.Services {
-		for _, met := range svc.Methods {
-			mopts := MethodOptions(met)
-			if mopts != nil && len(mopts.Resolves) > 0 {
-				for _, res := range mopts.Resolves {
-					// unmapped resolvers will be mapped during schema generation
-					tg.resolvers.unmapped[res] = met
-
-					// map unique services that resolve
-					tg.resolvers.methods[met] = struct{}{}
-					tg.resolvers.services[met.Parent] = struct{}{}
-				}
-			}
-		}
-	}
+	tg.indexResolvers()
 
 	// generate the graphql schema, also populating the field index
-	if err := tg.generateSchema(); err != nil {
-		return fmt.Errorf("failed to generate schema: %w", err)
-	}
+	tg.generateSchema()
 
 	// fail if resolving was configured but no field hooked it up after generating the schema
-	if len(tg.resolvers.unmapped) > 0 {
-		for q, met := range tg.resolvers.unmapped {
-			return fmt.Errorf("%s.%s resolves field '%s' but it was not found under the root messages (%s, %s or %s)",
-				met.Parent.Desc.Name(), met.Desc.Name(),
-				q,
-				tg.gen.opts.QueryMessageName,
-				tg.gen.opts.MutationMessageName,
-				tg.gen.opts.SubscriptionMessageName)
-		}
+	tg.checkUnmapped()
+	tg.checkInputAuth()
+	if len(tg.diags) > 0 {
+		return tg.diagnostics()
 	}
 
-	// output the graphql schema text
-	formatter.NewFormatter(graphw).FormatSchema(tg.sch)
+	// format the graphql schema text, with a schema block if the root types are not named by default
+	var graph bytes.Buffer
+	if err := tg.writeSchemaBlock(&graph); err != nil {
+		return fmt.Errorf("failed to write schema block: %w", err)
+	}
+
+	formatter.NewFormatter(&graph).FormatSchemaDocument(tg.schemaDocument())
 
-	// generate and output the resolving code
-	if err := tg.gen.tmpl.ExecuteTemplate(resolvew, "resolve.gotmpl", TargetData{
+	// check the schema text like AppSync will, before it is output
+	if tg.validateSchema(graph.String()); len(tg.diags) > 0 {
+		return tg.diagnostics()
+	}
+
+	if _, err := graphw.Write(graph.Bytes()); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+
+	// generate and output the resolving code, with the services and methods in the order they're declared
+	svcs, mets := tg.resolverMethods()
+	if err := tg.gen.tmpl.ExecuteTemplate(resolvef, "resolve.gotmpl", TargetData{
 		File:             tg.file,
-		Resolvers:        tg.resolvers.mapped,
-		ResolverMethods:  tg.resolvers.methods,
-		ResolverServices: tg.resolvers.services,
+		Resolve:          resolvef,
+		Options:          tg.gen.opts,
+		Resolvers:        tg.orderedResolvers(),
+		ResolverMethods:  mets,
+		ResolverServices: svcs,
+		Connections:      tg.resolvers.connections,
+		ResultFields:     tg.resolvers.results,
+		Nodes:            tg.nodes,
+		NodeService:      tg.nodeService,
+		NodeQualifier:    tg.nodeQualifier,
 	}); err != nil {
 		return fmt.Errorf("failed to generate resolving code: %w", err)
 	}
@@ -88,30 +125,112 @@ func (tg *Target) Generate(graphw, resolvew io.Writer) error {
 }
 
 // generateSchema populate the target's graphql schema definition
-func (tg *Target) generateSchema() error {
+func (tg *Target) generateSchema() {
 
 	// find the messages that make up the root graphql types: Query, Mutation and Subscription
 	for _, msg := range tg.file.Messages {
-		switch string(msg.Desc.Name()) {
-		case tg.gen.opts.QueryMessageName, tg.gen.opts.MutationMessageName, tg.gen.opts.SubscriptionMessageName:
+		op := tg.rootOperation(msg)
+		if op == "" {
+			continue
+		}
 
-			// one of the roots of the graphql tree, start recursing down to generate schema definitions
-			_, err := tg.generateMessage(false, msg)
-			if err != nil {
-				return fmt.Errorf("failed to generate message '%s': %w", msg.Desc.Name(), err)
-			}
+		// one of the roots of the graphql tree, start recursing down to generate schema definitions
+		def, err := tg.generateMessage(false, msg)
+		if err != nil {
+			tg.report(msg.Desc, err)
+			continue
+		}
 
-		default:
+		switch op {
+		case ast.Query:
+			tg.sch.Query = def
+		case ast.Mutation:
+			tg.sch.Mutation = def
+		case ast.Subscription:
+			tg.sch.Subscription = def
+		}
+	}
+
+	// implementations can only be checked once all of their fields are generated
+	tg.checkInterfaces()
+
+	// relay nodes that were found while generating can be fetched through the query type
+	if err := tg.generateNodeField(); err != nil {
+		tg.report(tg.file.Desc, fmt.Errorf("failed to generate node field: %w", err))
+	}
+}
+
+// schemaDocument returns the generated types as a document, for formatting them in the order that the
+// protobuf elements they're generated from are declared in, instead of alphabetically. Types that are
+// generated from the same element keep the order that they were generated in.
+func (tg *Target) schemaDocument() *ast.SchemaDocument {
+	order := append([]string{}, tg.order...)
+	sort.SliceStable(order, func(i, j int) bool {
+		return tg.declaredBefore(tg.names[order[i]], tg.names[order[j]])
+	})
+
+	doc := &ast.SchemaDocument{}
+	for _, name := range order {
+		if def, ok := tg.sch.Types[name]; ok {
+			doc.Definitions = append(doc.Definitions, def)
+		}
+	}
+
+	names := make([]string, 0, len(tg.sch.Directives))
+	for name := range tg.sch.Directives {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		doc.Directives = append(doc.Directives, tg.sch.Directives[name])
+	}
+
+	return doc
+}
+
+// writeSchemaBlock writes the schema block that declares the root types if any of them is not named by
+// default. The formatter would only list the roots that are named differently, but once a schema block
+// is present the other types are no longer considered roots. So all of them are listed here.
+func (tg *Target) writeSchemaBlock(w io.Writer) (err error) {
+	roots := []struct {
+		op  ast.Operation
+		def *ast.Definition
+	}{{ast.Query, tg.sch.Query}, {ast.Mutation, tg.sch.Mutation}, {ast.Subscription, tg.sch.Subscription}}
+
+	var custom bool
+	for _, root := range roots {
+		custom = custom || (root.def != nil && root.def.Name != rootNames[root.op])
+	}
+
+	if !custom {
+		return nil
+	}
+
+	if _, err = fmt.Fprintf(w, "schema {\n"); err != nil {
+		return err
+	}
+
+	for _, root := range roots {
+		if root.def == nil {
 			continue
 		}
+
+		if _, err = fmt.Fprintf(w, "\t%s: %s\n", root.op, root.def.Name); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	_, err = fmt.Fprintf(w, "}\n")
+	return err
 }
 
 // generateMessage generates graphql object/input type definitions from protobuf messages
 func (tg *Target) generateMessage(isInput bool, msg *protogen.Message) (def *ast.Definition, err error) {
-	def = &ast.Definition{Name: msg.GoIdent.GoName, Kind: ast.Object, Fields: ast.FieldList{}}
+	def = &ast.Definition{Kind: ast.Object, Fields: ast.FieldList{}, Description: description(msg.Comments.Leading)}
+	if def.Name, err = tg.messageName(msg); err != nil {
+		return nil, diagnose(msg.Desc, err)
+	}
 
 	// if we're traversing the input side of the graph, create input defs instead
 	if isInput {
@@ -120,45 +239,301 @@ func (tg *Target) generateMessage(isInput bool, msg *protogen.Message) (def *ast
 	}
 
 	// if it's already defined we don't do it again, else it causes infinite loops in case of recursion
-	if _, ok := tg.sch.Types[def.Name]; ok {
+	if claimed, err := tg.claimName(def.Name, msg.Desc); err != nil {
+		return nil, diagnose(msg.Desc, err)
+	} else if claimed {
 		return tg.sch.Types[def.Name], nil
 	}
 
 	// add the type in the graphql schema, return the name
 	tg.sch.Types[def.Name] = def
 
+	// interface messages are implemented by the messages in their oneof
+	if MessageOptions(msg).GetInterface() {
+		if isInput {
+			return nil, fmt.Errorf("interface '%s' can't be used as input", msg.Desc.FullName())
+		}
+
+		if err := tg.generateInterface(def, msg); err != nil {
+			tg.report(msg.Desc, err)
+		}
+
+		return def, nil
+	}
+
+	// authorization directives only apply to object types
+	tg.useAuth(msg.Desc, MessageOptions(msg).GetAuth(), isInput)
+	if !isInput {
+		if def.Directives, err = generateAuth(MessageOptions(msg).GetAuth()); err != nil {
+			tg.report(msg.Desc, fmt.Errorf("invalid auth option: %w", err))
+		} else if def.Directives == nil {
+			def.Directives = tg.defaultAuthDirectives()
+		}
+	}
+
 	// generate graphql field definitions for each field in the message
+	def.Fields = tg.generateFields(isInput, msg)
+
+	// graphql doesn't allow object types without fields, so empty messages get a placeholder
+	if len(def.Fields) < 1 && !isInput {
+		def.Fields = ast.FieldList{{Name: emptyFieldName, Type: ast.NamedType("Boolean", nil)}}
+	}
+
+	// relay nodes implement the Node interface
+	if MessageOptions(msg).GetNode() != nil && !isInput {
+		if err := tg.generateNode(def, msg); err != nil {
+			tg.report(msg.Desc, fmt.Errorf("invalid node option: %w", err))
+		}
+	}
+
+	return def, nil
+}
+
+// generateFields generates graphql field definitions for the fields of a protobuf message. Fields that
+// can't be generated are reported, and left out.
+func (tg *Target) generateFields(isInput bool, msg *protogen.Message) (defs ast.FieldList) {
+	defs = ast.FieldList{}
+	oneofs := map[*protogen.Oneof]bool{}
 	for _, fld := range msg.Fields {
 		if fopts := FieldOptions(fld); fopts != nil && fopts.Ignore != nil && *fopts.Ignore {
+			if met, ok := tg.resolvers.unmapped[resolverQualifier(fld)]; ok {
+				tg.report(fld.Desc, fmt.Errorf("field '%s' is ignored, so it can't be resolved by rpc '%s'",
+					fld.Desc.FullName(), met.Desc.FullName()))
+				delete(tg.resolvers.unmapped, resolverQualifier(fld))
+			}
+
 			continue // skip ignored field
 		}
 
+		// members of a oneof are generated together, when we come across the first member
+		if fld.Oneof != nil && !fld.Oneof.Desc.IsSynthetic() {
+			if oneofs[fld.Oneof] {
+				continue
+			}
+
+			oneofs[fld.Oneof] = true
+			odefs, err := tg.generateOneof(isInput, fld.Oneof)
+			if err != nil {
+				tg.report(fld.Oneof.Desc, err)
+				continue
+			}
+
+			defs = append(defs, odefs...)
+			continue
+		}
+
 		fdef, err := tg.generateField(isInput, fld)
 		if err != nil {
-			return nil, fmt.Errorf("failed to generate field '%s': %w", fld.Desc.Name(), err)
+			tg.report(fld.Desc, err)
+			continue
+		} else if fdef == nil {
+			continue // omitted
 		}
 
-		def.Fields = append(def.Fields, fdef)
+		defs = append(defs, fdef)
 	}
 
-	return def, nil
+	// with field names being configurable, make sure they remain unique
+	names := map[string]bool{}
+	for _, def := range defs {
+		if names[def.Name] {
+			tg.report(msg.Desc, fmt.Errorf("graphql field name '%s' is used more than once in '%s'",
+				def.Name, msg.Desc.FullName()))
+		}
+
+		names[def.Name] = true
+	}
+
+	return defs
+}
+
+// generateOneof generates graphql field definitions for the members of a protobuf oneof. Only one member is
+// ever set so on the output side they become nullable fields, accompanied by a field of an enum type that
+// tells which member is set. On the input side the members are grouped into a separate input object that
+// allows only one of them to be set.
+func (tg *Target) generateOneof(isInput bool, oneof *protogen.Oneof) (defs ast.FieldList, err error) {
+	var members []*protogen.Field
+	for _, fld := range oneof.Fields {
+		if fopts := FieldOptions(fld); fopts != nil && fopts.Ignore != nil && *fopts.Ignore {
+			continue // skip ignored member
+		}
+
+		if isInput && isEmptyField(fld, map[*protogen.Message]bool{}) {
+			continue // skip members that are omitted from input
+		}
+
+		members = append(members, fld)
+	}
+
+	if len(members) < 1 {
+		return nil, nil // all members are ignored
+	}
+
+	name, err := tg.messageName(oneof.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	name += oneof.GoName
+	if isInput {
+		claimed, err := tg.claimName(name+"Input", oneof.Desc)
+		if err != nil {
+			return nil, err
+		}
+
+		def := tg.sch.Types[name+"Input"]
+		if !claimed {
+			def = &ast.Definition{Name: name + "Input", Kind: ast.InputObject, Fields: ast.FieldList{}}
+			// AppSync doesn't allow custom directives like @oneOf, only decoding checks that one member is set
+			def.Description = appendDescription(description(oneof.Comments.Leading),
+				"Exactly one of the fields must be set.")
+			tg.sch.Types[def.Name] = def
+
+			for _, fld := range members {
+				fdef, err := tg.generateField(true, fld)
+				if err != nil {
+					tg.report(fld.Desc, err)
+					continue
+				}
+
+				fdef.Type.NonNull = false
+				def.Fields = append(def.Fields, fdef)
+			}
+		}
+
+		return ast.FieldList{{
+			Name:        appsyncjson.OneofFieldName(oneof.Desc, tg.gen.opts.ProtoNames),
+			Type:        ast.NamedType(def.Name, nil),
+			Description: description(oneof.Comments.Leading),
+		}}, nil
+	}
+
+	// enum with a value for each member, for the field that tells which member is set
+	edef := &ast.Definition{Kind: ast.Enum, Name: name + "Case", EnumValues: ast.EnumValueList{}}
+	edef.Description = description(oneof.Comments.Leading)
+	if _, err := tg.claimName(edef.Name, oneof.Desc); err != nil {
+		return nil, err
+	}
+
+	for _, fld := range members {
+		edef.EnumValues = append(edef.EnumValues, &ast.EnumValueDefinition{
+			Name:        appsyncjson.OneofCaseValue(fld.Desc),
+			Description: description(fld.Comments.Leading),
+		})
+	}
+
+	tg.sch.Types[edef.Name] = edef
+	defs = append(defs, &ast.FieldDefinition{
+		Name:        appsyncjson.OneofCaseFieldName(oneof.Desc, tg.gen.opts.ProtoNames),
+		Type:        ast.NamedType(edef.Name, nil),
+		Description: edef.Description,
+	})
+
+	for _, fld := range members {
+		fdef, err := tg.generateField(false, fld)
+		if err != nil {
+			tg.report(fld.Desc, err)
+			continue
+		}
+
+		fdef.Type.NonNull = false
+		defs = append(defs, fdef)
+	}
+
+	return defs, nil
 }
 
 // generateField generates graphql field definitions from the protobuf message field
 func (tg *Target) generateField(isInput bool, fld *protogen.Field) (def *ast.FieldDefinition, err error) {
-	def = &ast.FieldDefinition{Name: fld.Desc.JSONName(), Type: &ast.Type{NonNull: true}}
+	def = &ast.FieldDefinition{Type: &ast.Type{NonNull: true}, Description: description(fld.Comments.Leading)}
 
 	// if a rpc method was configured to be resolving this field, add any arguments.
 	// if we're building input the fields never have arguments
-	protoQualifier := fmt.Sprintf("%s.%s", fld.Parent.Desc.Name(), fld.Desc.Name())
-	graphQualifier := fmt.Sprintf("%s.%s", fld.Parent.Desc.Name(), fld.Desc.JSONName())
-	if resolver, ok := tg.resolvers.unmapped[protoQualifier]; ok && !isInput {
-		if def.Arguments, err = tg.generateArguments(fld, resolver); err != nil {
-			return nil, fmt.Errorf("failed to generate arguments: %w", err)
+	protoQualifier := resolverQualifier(fld)
+	resolver, resolved := tg.resolvers.unmapped[protoQualifier]
+	if resolved && isInput {
+		tg.resolvers.inputs[protoQualifier] = fld // to explain why it remains unmapped, if it does
+		resolver, resolved = nil, false
+	} else if resolved {
+		delete(tg.resolvers.unmapped, protoQualifier) // remove from map so we can error if some resolves failed
+	}
+
+	if def.Name, err = fieldName(fld, tg.gen.opts.ProtoNames); err != nil {
+		return nil, err
+	}
+
+	parentName, err := tg.messageName(fld.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// deprecated fields are marked as such
+	if dir := fieldDeprecation(fld); dir != nil {
+		def.Directives = append(def.Directives, dir)
+	}
+
+	// fields of object types can override the authorization of their type
+	tg.useAuth(fld.Desc, FieldOptions(fld).GetAuth(), isInput)
+	if !isInput {
+		dirs, err := generateAuth(FieldOptions(fld).GetAuth())
+		if err != nil {
+			return nil, fmt.Errorf("invalid auth option: %w", err)
 		}
 
-		delete(tg.resolvers.unmapped, protoQualifier)  // remove from map so we can error if some resolves failed
-		tg.resolvers.mapped[graphQualifier] = resolver // add to resolver map for generating go resolver code
+		def.Directives = append(def.Directives, dirs...)
+	}
+
+	// fields resolved by a paginated method can be exposed as a relay connection of its items
+	var conn *Connection
+
+	graphQualifier := fmt.Sprintf("%s.%s", parentName, def.Name)
+	if resolved {
+		if result, err := checkBinding(fld, resolver); err != nil {
+			return nil, diagnose(resolver.Desc, err)
+		} else if result != nil {
+			tg.resolvers.results[resolver] = result
+		}
+
+		def.Arguments = tg.generateArguments(fld, resolver)
+
+		if conn, err = connection(resolver); err != nil {
+			return nil, diagnose(resolver.Desc,
+				fmt.Errorf("invalid connection option of method '%s': %w", resolver.Desc.FullName(), err))
+		} else if conn != nil {
+			if def.Arguments, err = tg.generateConnectionArguments(def.Arguments, conn); err != nil {
+				return nil, diagnose(resolver.Desc, fmt.Errorf("failed to generate connection arguments: %w", err))
+			}
+
+			tg.resolvers.connections[resolver] = conn
+		}
+
+		// the method describes the field that it resolves
+		if desc := description(resolver.Comments.Leading); desc != "" {
+			def.Description = desc
+		}
+
+		// the method can configure the authorization for the field that it resolves, instead of the field
+		if mauth := MethodOptions(resolver).GetAuth(); mauth != nil {
+			if FieldOptions(fld).GetAuth() != nil {
+				return nil, fmt.Errorf("auth is configured on both the field and the method '%s' that resolves it",
+					resolver.Desc.FullName())
+			}
+
+			dirs, err := generateAuth(mauth)
+			if err != nil {
+				return nil, diagnose(resolver.Desc,
+					fmt.Errorf("invalid auth option of method '%s': %w", resolver.Desc.FullName(), err))
+			}
+
+			def.Directives = append(def.Directives, dirs...)
+		}
+
+		// the field is deprecated as well if the method that resolves it is
+		if dir := methodDeprecation(resolver); dir != nil && def.Directives.ForName(dir.Name) == nil {
+			def.Directives = append(def.Directives, dir)
+		}
+
+		tg.resolvers.mapped[protoQualifier] = &Resolver{Qualifier: graphQualifier, Method: resolver} // for generating go resolver code
 	}
 
 	// the explicit optional keyword is different from the "optional" cardinality
@@ -166,11 +541,98 @@ func (tg *Target) generateField(isInput bool, fld *protogen.Field) (def *ast.Fie
 		def.Type.NonNull = false
 	}
 
+	// whether the value may be null, also when it is the element of a list
+	var nullValue bool
+
+	fopts := FieldOptions(fld)
 	switch {
 
+	// the field resolves to a connection instead of the paginated response
+	case conn != nil:
+		if fld.Desc.Cardinality() == protoreflect.Repeated {
+			return nil, fmt.Errorf("field resolved by a connection must not be repeated")
+		}
+
+		cdef, err := tg.generateConnection(conn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate connection definition: %w", err)
+		}
+
+		def.Type.NamedType = cdef.Name
+
+	// message and map fields can be configured to be exposed as their protojson encoding
+	case fopts != nil && fopts.Json != nil && *fopts.Json:
+		if fld.Desc.Kind() != protoreflect.MessageKind {
+			return nil, fmt.Errorf("only message and map fields can be exposed as json, got: %v", fld.Desc.Kind())
+		}
+
+		def.Type.NamedType = "AWSJSON"
+		if fld.Desc.IsMap() {
+			return def, nil // the whole map is a single json object
+		}
+
+	// maps are exposed as a list of key/value entries
+	case fld.Desc.IsMap():
+		mdef, err := tg.generateMapEntry(isInput, fld)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate map entry definition: %w", err)
+		}
+
+		def.Type.NamedType = mdef.Name
+
 	// basic scalar types
 	case fld.Desc.Kind() == protoreflect.StringKind:
 		def.Type.NamedType = "String"
+	case fld.Desc.Kind() == protoreflect.BoolKind:
+		def.Type.NamedType = "Boolean"
+
+	// protojson encodes all 32-bit integers as json numbers. A graphql Int is a signed 32-bit integer, so
+	// unsigned ones are exposed as a Float, which holds all of their values exactly.
+	case fld.Desc.Kind() == protoreflect.Int32Kind,
+		fld.Desc.Kind() == protoreflect.Sint32Kind,
+		fld.Desc.Kind() == protoreflect.Sfixed32Kind:
+		def.Type.NamedType = "Int"
+	case fld.Desc.Kind() == protoreflect.Uint32Kind,
+		fld.Desc.Kind() == protoreflect.Fixed32Kind:
+		def.Type.NamedType = "Float"
+
+	// protojson encodes all 64-bit integers as json strings, to prevent precision loss
+	case fld.Desc.Kind() == protoreflect.Int64Kind,
+		fld.Desc.Kind() == protoreflect.Sint64Kind,
+		fld.Desc.Kind() == protoreflect.Sfixed64Kind,
+		fld.Desc.Kind() == protoreflect.Uint64Kind,
+		fld.Desc.Kind() == protoreflect.Fixed64Kind:
+		def.Type.NamedType = "String"
+
+	// floating point numbers. Note that protojson encodes NaN and Infinity as strings
+	case fld.Desc.Kind() == protoreflect.FloatKind,
+		fld.Desc.Kind() == protoreflect.DoubleKind:
+		def.Type.NamedType = "Float"
+
+	// protojson encodes bytes as a standard base64 string
+	case fld.Desc.Kind() == protoreflect.BytesKind:
+		def.Type.NamedType = "String"
+
+	// well-known messages are encoded by protojson in a special way, they map onto scalars
+	case fld.Desc.Kind() == protoreflect.MessageKind && isWellKnown(fld.Message):
+		wks, _ := appsyncjson.WellKnown(fld.Message.Desc)
+		def.Type.NamedType = wks.Name
+		if wks.Nullable {
+			def.Type.NonNull = false
+		}
+
+		nullValue = wks.NullValue
+
+	// empty messages are omitted from input, and exposed as a Boolean on output if configured
+	case fld.Desc.Kind() == protoreflect.MessageKind && isEmpty(isInput, fld.Message) &&
+		(isInput || tg.gen.opts.EmptyMessages == EmptyMessageBoolean):
+		if isInput {
+			return nil, nil
+		}
+
+		def.Type.NamedType = "Boolean"
+		def.Type.NonNull = false
+		nullValue = true
 
 	// messages are an object and recurse
 	case fld.Desc.Kind() == protoreflect.MessageKind:
@@ -194,56 +656,164 @@ func (tg *Target) generateField(isInput bool, fld *protogen.Field) (def *ast.Fie
 
 		def.Type.NamedType = edef.Name
 
+		// without a zero value in the schema, the zero value is exposed as null. As input it can
+		// be omitted, but lists never accept null elements.
+		if EnumOptions(fld.Enum).GetOmitZero() {
+			def.Type.NonNull = false
+			nullValue = !isInput
+		}
+
 	default:
 		return nil, fmt.Errorf("unsupported field: Kind=%v Desc=%v", fld.Desc.Kind(), fld.Desc)
 	}
 
+	// protoc-gen-validate rules of the field are reflected in the schema
+	generateValidation(def, fld)
+
+	// scalar fields can be declared as one of the other (AWS) scalars that graphql supports
+	if fopts != nil && fopts.Type != nil {
+		if err := appsyncjson.CheckScalar(*fopts.Type, fld.Desc.Kind()); err != nil {
+			return nil, fmt.Errorf("invalid type option: %w", err)
+		}
+
+		def.Type.NamedType = *fopts.Type
+	}
+
+	// the id of a relay node holds its global id
+	if isNodeIDField(fld) {
+		def.Type.NamedType = "ID"
+	}
+
 	// for repeated fields we turn the field type into the element instead
 	if fld.Desc.Cardinality() == protoreflect.Repeated {
 		switch {
-		case fld.Desc.IsList():
+		case fld.Desc.IsList(), fld.Desc.IsMap():
 
-			// we never allow "null" to be passed as list value
-			def.Type.Elem = &ast.Type{NamedType: def.Type.NamedType, NonNull: true}
+			// we never allow "null" to be passed as list value, and protojson always emits a list
+			def.Type.Elem = &ast.Type{NamedType: def.Type.NamedType, NonNull: !nullValue}
 			def.Type.NamedType = "" // reset to non-named, to allow elem
-		case fld.Desc.IsMap():
-			return nil, fmt.Errorf("maps using the 'shortcut' notation are not supported, use the 'legacy' structure instead (https://github.com/golang/protobuf/issues/1511)")
+			def.Type.NonNull = true
 		default:
 			return nil, fmt.Errorf("unsupported repeated cardinality, not List or Map")
 		}
 	}
 
+	// input fields, and the arguments they become, can declare the value that applies when omitted
+	dval, err := generateDefault(fld, def.Type)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default option: %w", err)
+	} else if isInput {
+		def.DefaultValue = dval
+	}
+
+	// fields of the subscription message can be triggered by mutations. They are nullable since AppSync
+	// resolves them to null when a client subscribes.
+	if sub := fopts.GetSubscribe(); sub != nil && !isInput {
+		dir, args, err := tg.generateSubscribe(fld, sub)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscribe option: %w", err)
+		}
+
+		if len(args) > 0 && len(def.Arguments) > 0 {
+			return nil, fmt.Errorf("subscription arguments can't be combined with the arguments of a resolver")
+		}
+
+		def.Directives = append(def.Directives, dir)
+		def.Arguments = append(def.Arguments, args...)
+		def.Type.NonNull = false
+	}
+
 	return
 }
 
-// generateEnum generates graphql enum type from protobuf enum field
-func (tg *Target) generateEnum(isInput bool, enum *protogen.Enum) (def *ast.Definition, err error) {
-	def = &ast.Definition{Kind: ast.Enum, Name: enum.GoIdent.GoName, EnumValues: ast.EnumValueList{}}
-	for _, val := range enum.Values {
+// generateMapEntry generates a graphql object/input type definition for the entries of a protobuf map field.
+// The map is exposed as a list of these entries since graphql has no notion of maps.
+func (tg *Target) generateMapEntry(isInput bool, fld *protogen.Field) (def *ast.Definition, err error) {
+	parentName, err := tg.messageName(fld.Parent)
+	if err != nil {
+		return nil, err
+	}
 
-		// we cut off the first prefix to make the protojson decoding work. The Go ident always includes the
-		// enum name in the first part before the "_" so we turn "Status_STATUS_OK" into "STATUS_OK"
-		_, name, _ := strings.Cut(val.GoIdent.GoName, "_")
-		def.EnumValues = append(def.EnumValues, &ast.EnumValueDefinition{Name: name})
+	def = &ast.Definition{Name: parentName + fld.GoName + "Entry", Kind: ast.Object}
+	if isInput {
+		def.Kind = ast.InputObject
+		def.Name = def.Name + "Input"
+	} else if def.Directives, err = generateAuth(FieldOptions(fld).GetAuth()); err != nil {
+		return nil, fmt.Errorf("invalid auth option: %w", err)
+	} else if def.Directives == nil {
+		// the entries are as accessible as the map field, which has the authorization of its message
+		def.Directives = copyDirectives(tg.sch.Types[parentName].Directives)
+	}
+
+	if claimed, err := tg.claimName(def.Name, fld.Desc); err != nil {
+		return nil, err
+	} else if claimed {
+		return tg.sch.Types[def.Name], nil
 	}
 
 	tg.sch.Types[def.Name] = def
+
+	// the synthetic entry message always has a "key" and a "value" field
+	def.Fields = tg.generateFields(isInput, fld.Message)
 	return def, nil
 }
 
-// generateArguments generates graphql arguments from the service method in the options
-func (tg *Target) generateArguments(fld *protogen.Field, res *protogen.Method) (def ast.ArgumentDefinitionList, err error) {
-	for _, infld := range res.Input.Fields {
-		if fopts := FieldOptions(infld); fopts != nil && fopts.Ignore != nil && *fopts.Ignore {
-			continue // skip argument if field is ignored
+// generateEnum generates graphql enum type from protobuf enum field
+func (tg *Target) generateEnum(isInput bool, enum *protogen.Enum) (def *ast.Definition, err error) {
+	def = &ast.Definition{Kind: ast.Enum, EnumValues: ast.EnumValueList{}, Description: description(enum.Comments.Leading)}
+	if def.Name, err = enumName(enum); err != nil {
+		return nil, diagnose(enum.Desc, err)
+	}
+
+	// enums are the same for input and output, so it might be generated already
+	if claimed, err := tg.claimName(def.Name, enum.Desc); err != nil {
+		return nil, diagnose(enum.Desc, err)
+	} else if claimed {
+		return tg.sch.Types[def.Name], nil
+	}
+
+	// proto2 enums don't need a zero value, but the option requires one to omit
+	omitZero := EnumOptions(enum).GetOmitZero()
+	if omitZero && enum.Desc.Values().ByNumber(0) == nil {
+		return nil, diagnose(enum.Desc, fmt.Errorf("enum '%s' has no zero value to omit", enum.Desc.FullName()))
+	}
+
+	for _, val := range enum.Values {
+		if omitZero && val.Desc.Number() == 0 {
+			continue // the zero value (and its aliases) is exposed as null
 		}
 
-		fdef, err := tg.generateField(true, infld)
-		if err != nil {
-			return nil, fmt.Errorf("failed to generate argument field: %w", err)
+		// protojson encodes and decodes enum values by their name in the proto file. Aliases are
+		// included, protojson accepts them as input but always outputs the first value's name.
+		def.EnumValues = append(def.EnumValues, &ast.EnumValueDefinition{
+			Name:        string(val.Desc.Name()),
+			Description: description(val.Comments.Leading),
+		})
+
+		if dir := enumValueDeprecation(val); dir != nil {
+			def.EnumValues[len(def.EnumValues)-1].Directives = ast.DirectiveList{dir}
 		}
+	}
 
-		def = append(def, &ast.ArgumentDefinition{Name: fdef.Name, Type: fdef.Type})
+	if len(def.EnumValues) < 1 {
+		return nil, diagnose(enum.Desc, fmt.Errorf("enum '%s' has no values left to expose", enum.Desc.FullName()))
+	}
+
+	tg.sch.Types[def.Name] = def
+	return def, nil
+}
+
+// generateArguments generates graphql arguments from the service method in the options
+func (tg *Target) generateArguments(fld *protogen.Field, res *protogen.Method) (def ast.ArgumentDefinitionList) {
+	tg.useAuth(res.Input.Desc, MessageOptions(res.Input).GetAuth(), true)
+	for _, fdef := range tg.generateFields(true, res.Input) {
+		def = append(def, &ast.ArgumentDefinition{
+			Name:         fdef.Name,
+			Type:         fdef.Type,
+			Description:  fdef.Description,
+			DefaultValue: fdef.DefaultValue,
+			Directives:   fdef.Directives,
+		})
 	}
 
 	return
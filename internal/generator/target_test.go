@@ -0,0 +1,1714 @@
+package generator_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	"github.com/crewlinker/protoc-gen-appsync-go/internal/generator"
+	nestedv1 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/nested/v1"
+	simplev1 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/simple/v1"
+	"github.com/crewlinker/protoc-gen-appsync-go/proto/examples/simple/v1/simplev1connect"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// the schemas and resolvers generated from the example files, with the options they're generated with
+var simpleGraph, simpleRes, nestedGraph, nestedRes string
+
+var _ = BeforeSuite(func() {
+	var err error
+	simpleGraph, simpleRes, err = generate(&generator.Options{QueryMessageName: "Query"},
+		simplev1.File_examples_simple_v1_simple_proto)
+	Expect(err).ToNot(HaveOccurred())
+
+	nestedGraph, nestedRes, err = generate(&generator.Options{
+		QueryMessageName: "Query", MutationMessageName: "Mutation", SubscriptionMessageName: "Subscription",
+	}, nestedv1.File_examples_nested_v1_nested_proto)
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = Describe("scalar kinds", func() {
+	DescribeTable("field types", func(field string) {
+		Expect(simpleGraph).To(ContainSubstring("type ScalarKinds {"))
+		Expect(simpleGraph).To(ContainSubstring("input ScalarKindsInput {"))
+		Expect(simpleGraph).To(ContainSubstring("\t" + field + "\n"))
+	},
+		Entry("double", "doubleValue: Float!"),
+		Entry("float", "floatValue: Float!"),
+		Entry("int32", "int32Value: Int!"),
+		Entry("int64", "int64Value: String!"),
+		Entry("uint32", "uint32Value: Float!"),
+		Entry("uint64", "uint64Value: String!"),
+		Entry("sint32", "sint32Value: Int!"),
+		Entry("sint64", "sint64Value: String!"),
+		Entry("fixed32", "fixed32Value: Float!"),
+		Entry("fixed64", "fixed64Value: String!"),
+		Entry("sfixed32", "sfixed32Value: Int!"),
+		Entry("sfixed64", "sfixed64Value: String!"),
+		Entry("bool", "boolValue: Boolean!"),
+		Entry("string", "stringValue: String!"),
+		Entry("bytes", "bytesValue: String!"),
+	)
+
+	It("should resolve all kinds through protojson", func() {
+		data, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "echoKinds", []byte(`{"kinds":{
+			"doubleValue": 1.5, "floatValue": 2.5, "int32Value": -3, "int64Value": "-4", "uint32Value": 4294967295,
+			"uint64Value": "6", "sint32Value": -7, "sint64Value": "-8", "fixed32Value": 9.0, "fixed64Value": "10",
+			"sfixed32Value": -11, "sfixed64Value": "-12", "boolValue": true, "stringValue": "foo", "bytesValue": "YmFy"
+		}}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		var out struct{ Kinds map[string]any }
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out.Kinds).To(Equal(map[string]any{
+			"doubleValue": 1.5, "floatValue": 2.5, "int32Value": -3.0, "int64Value": "-4", "uint32Value": 4294967295.0,
+			"uint64Value": "6", "sint32Value": -7.0, "sint64Value": "-8", "fixed32Value": 9.0, "fixed64Value": "10",
+			"sfixed32Value": -11.0, "sfixed64Value": "-12", "boolValue": true, "stringValue": "foo", "bytesValue": "YmFy",
+		}))
+	})
+
+	It("should emit zero values for non-null fields", func() {
+		data, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "echoKinds", []byte(`{"kinds":{}}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		var out struct{ Kinds map[string]any }
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out.Kinds).To(HaveLen(15))
+		Expect(out.Kinds).To(HaveKeyWithValue("boolValue", false))
+		Expect(out.Kinds).To(HaveKeyWithValue("int64Value", "0"))
+		Expect(out.Kinds).To(HaveKeyWithValue("bytesValue", ""))
+	})
+})
+
+var _ = Describe("well-known types", func() {
+	DescribeTable("field types", func(field string) {
+		Expect(simpleGraph).To(ContainSubstring("type WellKnownKinds {"))
+		Expect(simpleGraph).To(ContainSubstring("input WellKnownKindsInput {"))
+		Expect(simpleGraph).To(ContainSubstring("\t" + field + "\n"))
+		Expect(simpleGraph).ToNot(ContainSubstring("type Timestamp"))
+	},
+		Entry("timestamp", "timestampValue: AWSDateTime!"),
+		Entry("duration", "durationValue: String!"),
+		Entry("field mask", "fieldMaskValue: String!"),
+		Entry("struct", "structValue: AWSJSON!"),
+		Entry("list value", "listValue: AWSJSON!"),
+		Entry("value", "valueValue: AWSJSON"),
+		Entry("any", "anyValue: AWSJSON!"),
+		Entry("empty", "emptyValue: AWSJSON!"),
+		Entry("double wrapper", "doubleWrapper: Float"),
+		Entry("float wrapper", "floatWrapper: Float"),
+		Entry("int64 wrapper", "int64Wrapper: String"),
+		Entry("uint64 wrapper", "uint64Wrapper: String"),
+		Entry("int32 wrapper", "int32Wrapper: Int"),
+		Entry("uint32 wrapper", "uint32Wrapper: Float"),
+		Entry("bool wrapper", "boolWrapper: Boolean"),
+		Entry("string wrapper", "stringWrapper: String"),
+		Entry("bytes wrapper", "bytesWrapper: String"),
+	)
+
+	It("should allow null elements in lists of values that protojson encodes as null", func() {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			dependency: "google/protobuf/struct.proto"
+			message_type {
+				name: "Query"
+				field { name: "values" json_name: "values" number: 1 type: TYPE_MESSAGE type_name: ".google.protobuf.Value" label: LABEL_REPEATED }
+				field { name: "structs" json_name: "structs" number: 2 type: TYPE_MESSAGE type_name: ".google.protobuf.Struct" label: LABEL_REPEATED }
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("type Query {\n\tvalues: [AWSJSON]!\n\tstructs: [AWSJSON!]!\n}"))
+	})
+
+	It("should resolve well-known types through protojson", func() {
+		data, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "echoKinds", []byte(`{"wellKnown":{
+			"timestampValue": "2023-01-09T15:21:21.5Z", "durationValue": "1.5s", "fieldMaskValue": "foo,barBaz",
+			"structValue": {"foo": [1, "bar"]}, "listValue": [true], "valueValue": null, "emptyValue": {},
+			"anyValue": {"@type": "type.googleapis.com/google.protobuf.Empty", "value": {}},
+			"int64Wrapper": "-1", "boolWrapper": false, "stringWrapper": "foo"
+		}}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		var out struct{ WellKnown map[string]any }
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out.WellKnown).To(Equal(map[string]any{
+			"timestampValue": "2023-01-09T15:21:21.500Z", "durationValue": "1.500s", "fieldMaskValue": "foo,barBaz",
+			"structValue": map[string]any{"foo": []any{1.0, "bar"}}, "listValue": []any{true}, "valueValue": nil,
+			"anyValue":   map[string]any{"@type": "type.googleapis.com/google.protobuf.Empty", "value": map[string]any{}},
+			"emptyValue": map[string]any{}, "doubleWrapper": nil, "floatWrapper": nil, "int64Wrapper": "-1",
+			"uint64Wrapper": nil, "int32Wrapper": nil, "uint32Wrapper": nil, "boolWrapper": false,
+			"stringWrapper": "foo", "bytesWrapper": nil,
+		}))
+	})
+})
+
+var _ = Describe("oneofs", func() {
+	It("should generate nullable members and a discriminator for output", func() {
+		Expect(simpleGraph).To(ContainSubstring("type EchoResponse {\n\tmessage: String!\n\t" +
+			"decorationCase: EchoResponseDecorationCase\n\tprefix: String\n\trepeat: Int\n}"))
+		Expect(simpleGraph).To(ContainSubstring("enum EchoResponseDecorationCase {\n\tPREFIX\n\tREPEAT\n}"))
+	})
+
+	It("should generate an input object of optional members for input", func() {
+		Expect(simpleGraph).ToNot(ContainSubstring("@oneOf"))
+		Expect(simpleGraph).To(ContainSubstring("\"\"\"Exactly one of the fields must be set.\"\"\"\n" +
+			"input EchoRequestDecorationInput {\n\tprefix: String\n\trepeat: Int\n}"))
+		Expect(simpleGraph).To(ContainSubstring("echo(message: String!, leader: String! @deprecated, decoration: EchoRequestDecorationInput): EchoResponse!"))
+	})
+
+	It("should reject input with multiple members set", func() {
+		_, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "echo",
+			[]byte(`{"message":"foo","decoration":{"prefix":"bar","repeat":2}}`))
+		Expect(err).To(MatchError(ContainSubstring("must have at most one member set")))
+	})
+})
+
+var _ = Describe("maps", func() {
+	It("should expose the maps of the example", func() {
+		Expect(simpleGraph).To(ContainSubstring("\tstringKeys: [MapKindsStringKeysEntry!]!\n"))
+		Expect(simpleGraph).To(ContainSubstring("input MapKindsInt32KeysEntryInput {\n\tkey: Int!\n\tvalue: ScalarKindsInput!\n}"))
+		Expect(simpleGraph).To(ContainSubstring("\tjsonObject: AWSJSON!\n"))
+	})
+
+	DescribeTable("field types", func(keyKind, fieldOpts, field, entry string) {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field {
+					name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Query.FooEntry" label: LABEL_REPEATED
+					`+fieldOpts+`
+				}
+				nested_type {
+					name: "FooEntry"
+					field { name: "key" json_name: "key" number: 1 type: `+keyKind+` label: LABEL_OPTIONAL }
+					field { name: "value" json_name: "value" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+					options { map_entry: true }
+				}
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("type Query {\n\t" + field + "\n}"))
+		if entry == "" {
+			Expect(graph).ToNot(ContainSubstring("Entry"))
+		} else {
+			Expect(graph).To(ContainSubstring(entry))
+		}
+	},
+		Entry("string keys", "TYPE_STRING", ``, "foo: [QueryFooEntry!]!", "type QueryFooEntry {\n\tkey: String!\n\tvalue: String!\n}"),
+		Entry("int32 keys", "TYPE_INT32", ``, "foo: [QueryFooEntry!]!", "type QueryFooEntry {\n\tkey: Int!\n\tvalue: String!\n}"),
+		Entry("int64 keys", "TYPE_INT64", ``, "foo: [QueryFooEntry!]!", "type QueryFooEntry {\n\tkey: String!\n\tvalue: String!\n}"),
+		Entry("bool keys", "TYPE_BOOL", ``, "foo: [QueryFooEntry!]!", "type QueryFooEntry {\n\tkey: Boolean!\n\tvalue: String!\n}"),
+		Entry("json", "TYPE_STRING", `options { [appsync.v1.field] { json: true } }`, "foo: AWSJSON!", ""),
+	)
+
+	It("should only allow message and map fields to be exposed as json", func() {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field {
+					name: "foo" json_name: "foo" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL
+					options { [appsync.v1.field] { json: true } }
+				}
+			}`))
+		Expect(err).To(MatchError(ContainSubstring("only message and map fields can be exposed as json, got: string")))
+	})
+})
+
+var _ = Describe("scalar type option", func() {
+	It("should declare the fields of the example with the scalar type", func() {
+		Expect(simpleGraph).To(ContainSubstring("type AWSKinds {\n\tid: ID!\n\temail: AWSEmail!\n\turl: AWSURL!\n\t" +
+			"phone: AWSPhone!\n\tipAddress: AWSIPAddress!\n\tdate: AWSDate!\n\ttime: AWSTime!\n\t" +
+			"dateTime: AWSDateTime!\n\ttimestamp: AWSTimestamp!\n}"))
+		Expect(simpleGraph).To(ContainSubstring("profileIds: [ID!]!"))
+	})
+
+	It("should validate arguments in the resolver when configured", func() {
+		_, res, err := generate(&generator.Options{QueryMessageName: "Query", ValidateScalars: true},
+			simplev1.File_examples_simple_v1_simple_proto)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(ContainSubstring("(appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in)"))
+
+		_, err = simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "echoKinds",
+			[]byte(`{"aws":{"email":"foo"}}`))
+		Expect(err).To(MatchError(ContainSubstring("invalid AWSEmail value for field 'email'")))
+	})
+
+	DescribeTable("compatibility", func(typ, kind, expErr string) {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field {
+					name: "foo" json_name: "foo" number: 1 type: `+kind+` label: LABEL_OPTIONAL
+					options { [appsync.v1.field] { type: "`+typ+`" } }
+				}
+			}`))
+		if expErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(graph).To(ContainSubstring("type Query {\n\tfoo: " + typ + "!\n}"))
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+		}
+	},
+		Entry("string id", "ID", "TYPE_STRING", ""),
+		Entry("int64 id", "ID", "TYPE_INT64", ""),
+		Entry("int64 timestamp", "AWSTimestamp", "TYPE_INT64", ""),
+		Entry("string email", "AWSEmail", "TYPE_STRING", ""),
+		Entry("string date time", "AWSDateTime", "TYPE_STRING", ""),
+		Entry("string timestamp", "AWSTimestamp", "TYPE_STRING",
+			"scalar type 'AWSTimestamp' is not compatible with fields of kind 'string'"),
+		Entry("bool email", "AWSEmail", "TYPE_BOOL", "scalar type 'AWSEmail' is not compatible with fields of kind 'bool'"),
+		Entry("unknown", "AWSFoo", "TYPE_STRING", "unsupported scalar type 'AWSFoo'"),
+	)
+})
+
+var _ = Describe("enums", func() {
+	It("should generate values by their proto name, including aliases", func() {
+		Expect(simpleGraph).To(ContainSubstring("enum Mood {\n\tMOOD_UNSPECIFIED\n\tMOOD_HAPPY\n\tMOOD_JOYFUL @deprecated(reason: \"use MOOD_HAPPY\")\n\tMOOD_SAD\n}"))
+		Expect(simpleGraph).To(ContainSubstring("enum Level {\n\tLEVEL_UNSPECIFIED\n\tLEVEL_LOW\n\tLEVEL_HIGH\n}"))
+	})
+
+	It("should omit the zero value when configured", func() {
+		Expect(simpleGraph).To(ContainSubstring("enum Weather {\n\tWEATHER_SUNNY\n\tWEATHER_RAINY\n}"))
+		Expect(simpleGraph).To(ContainSubstring("type EnumKinds {\n\tmood: Mood!\n\tlevel: Level!\n\t" +
+			"weather: Weather\n\tforecast: [Weather]!\n}"))
+		Expect(simpleGraph).To(ContainSubstring("input EnumKindsInput {\n\tmood: Mood!\n\tlevel: Level!\n\t" +
+			"weather: Weather\n\tforecast: [Weather!]!\n}"))
+	})
+
+	It("should error when no values are left to expose", func() {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			enum_type {
+				name: "Foo"
+				value { name: "FOO_UNSPECIFIED" number: 0 }
+				options { [appsync.v1.enum] { omit_zero: true } }
+			}
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_ENUM type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}`))
+		Expect(err).To(MatchError(ContainSubstring("enum 'test.v1.Foo' has no values left to expose")))
+	})
+
+	It("should error when there is no zero value to omit", func() {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			syntax: "proto2"
+			enum_type {
+				name: "Foo"
+				value { name: "FOO_BAR" number: 1 }
+				options { [appsync.v1.enum] { omit_zero: true } }
+			}
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_ENUM type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}`))
+		Expect(err).To(MatchError(ContainSubstring("enum 'test.v1.Foo' has no zero value to omit")))
+	})
+
+	It("should resolve enums in input and output", func() {
+		data, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "echoKinds",
+			[]byte(`{"enums":{"mood":"MOOD_JOYFUL","level":"LEVEL_HIGH","forecast":["WEATHER_RAINY"]},"ack":null}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"kinds":null,"wellKnown":null,"maps":null,"aws":null,"enums":{
+			"mood":"MOOD_HAPPY","level":"LEVEL_HIGH","weather":null,"forecast":["WEATHER_RAINY"]},"ack":null}`))
+	})
+})
+
+var _ = Describe("defaults", func() {
+	It("should declare defaults and apply them in the resolver", func() {
+		Expect(simpleGraph).To(ContainSubstring("notifications(limit: Int! = 10): ListNotificationsResponse!"))
+
+		data, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "notifications", []byte(`{}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"notifications":[{"__typename":"SmsNotification","text":"limit 10","phone":""}]}`))
+
+		data, err = simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "notifications",
+			[]byte(`{"limit":3}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"notifications":[{"__typename":"SmsNotification","text":"limit 3","phone":""}]}`))
+	})
+
+	DescribeTable("values", func(field, exp, expErr string) {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			enum_type {
+				name: "Foo"
+				value { name: "FOO_UNSPECIFIED" number: 0 }
+				value { name: "FOO_BAR" number: 1 }
+				options { [appsync.v1.enum] { omit_zero: true } }
+			}
+			message_type {
+				name: "Query"
+				field { name: "baz" json_name: "baz" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Baz" label: LABEL_OPTIONAL }
+			}
+			message_type { name: "Baz" field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL } }
+			message_type {
+				name: "GetBazRequest"
+				field { name: "filter" json_name: "filter" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Filter" label: LABEL_OPTIONAL }
+			}
+			message_type { name: "Filter" field { name: "foo" json_name: "foo" number: 1 label: LABEL_OPTIONAL `+field+` } }
+			service {
+				name: "BazService"
+				method {
+					name: "GetBaz" input_type: ".test.v1.GetBazRequest" output_type: ".test.v1.Baz"
+					options { [appsync.v1.method] { resolves: "Query.baz" } }
+				}
+			}`))
+		if expErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+			return
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("input FilterInput {\n\tfoo: " + exp + "\n}"))
+	},
+		Entry("string", `type: TYPE_STRING options { [appsync.v1.field] { default: "a \"b\"" } }`, `String! = "a \"b\""`, ""),
+		Entry("bool", `type: TYPE_BOOL options { [appsync.v1.field] { default: "true" } }`, `Boolean! = true`, ""),
+		Entry("int32", `type: TYPE_INT32 options { [appsync.v1.field] { default: "-5" } }`, `Int! = -5`, ""),
+		Entry("uint32", `type: TYPE_UINT32 options { [appsync.v1.field] { default: "4294967295" } }`, `Float! = 4294967295`, ""),
+		Entry("int64", `type: TYPE_INT64 options { [appsync.v1.field] { default: "5" } }`, `String! = "5"`, ""),
+		Entry("timestamp", `type: TYPE_INT64 options { [appsync.v1.field] { type: "AWSTimestamp" default: "5" } }`,
+			`AWSTimestamp! = 5`, ""),
+		Entry("double", `type: TYPE_DOUBLE options { [appsync.v1.field] { default: "1.5e3" } }`, `Float! = 1.5e3`, ""),
+		Entry("enum", `type: TYPE_ENUM type_name: ".test.v1.Foo" options { [appsync.v1.field] { default: "FOO_BAR" } }`,
+			`Foo = FOO_BAR`, ""),
+		Entry("invalid bool", `type: TYPE_BOOL options { [appsync.v1.field] { default: "1" } }`, ``,
+			"invalid default '1' for field of kind 'bool': not a boolean"),
+		Entry("int32 overflow", `type: TYPE_INT32 options { [appsync.v1.field] { default: "3000000000" } }`, ``,
+			"invalid default '3000000000' for field of kind 'int32'"),
+		Entry("invalid number", `type: TYPE_DOUBLE options { [appsync.v1.field] { default: "NaN" } }`, ``,
+			"invalid default 'NaN' for field of kind 'double': not a number"),
+		Entry("unknown enum value", `type: TYPE_ENUM type_name: ".test.v1.Foo" options { [appsync.v1.field] { default: "FOO_BAZ" } }`,
+			``, "enum 'test.v1.Foo' has no value 'FOO_BAZ'"),
+		Entry("omitted enum value", `type: TYPE_ENUM type_name: ".test.v1.Foo" options { [appsync.v1.field] { default: "FOO_UNSPECIFIED" } }`,
+			``, "zero value 'FOO_UNSPECIFIED' of enum 'test.v1.Foo' is not exposed"),
+		Entry("invalid scalar", `type: TYPE_STRING options { [appsync.v1.field] { type: "AWSEmail" default: "foo" } }`, ``,
+			"invalid AWSEmail default 'foo'"),
+		Entry("message", `type: TYPE_MESSAGE type_name: ".test.v1.Baz" options { [appsync.v1.field] { default: "foo" } }`, ``,
+			"fields of kind 'message' can't have a default"),
+	)
+
+	It("should error on defaults of repeated fields", func() {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field {
+					name: "foo" json_name: "foo" number: 1 type: TYPE_STRING label: LABEL_REPEATED
+					options { [appsync.v1.field] { default: "foo" } }
+				}
+			}`))
+		Expect(err).To(MatchError(ContainSubstring("invalid default option: repeated fields can't have a default")))
+	})
+})
+
+var _ = Describe("resolver bindings", func() {
+	It("should resolve scalar fields with the result field of the response", func() {
+		Expect(simpleGraph).To(ContainSubstring("latestVersion: String!"))
+		Expect(simpleRes).To(ContainSubstring(`(appsyncjson.MarshalOptions{}).MarshalField(resp.Msg, "version")`))
+
+		data, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "latestVersion", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`"v1.2.3"`))
+	})
+
+	DescribeTable("errors", func(fooOpts, methods, expErr string) {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL `+fooOpts+` }
+				field { name: "name" json_name: "name" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+				field { name: "foos" json_name: "foos" number: 3 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_REPEATED }
+			}
+			message_type { name: "Foo" field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL } }
+			message_type {
+				name: "GetFooRequest"
+				field { name: "filter" json_name: "filter" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Filter" label: LABEL_OPTIONAL }
+			}
+			message_type { name: "Filter" field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL } }
+			service { name: "FooService" `+methods+` }`))
+		if expErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+		}
+	},
+		Entry("valid", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.foo" } }
+			}`, ""),
+		Entry("other message", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Filter"
+				options { [appsync.v1.method] { resolves: "Query.foo" } }
+			}`, "field 'test.v1.Query.foo' of type 'test.v1.Foo' can't be resolved by rpc 'test.v1.FooService.GetFoo', "+
+			"which returns 'test.v1.Filter'"),
+		Entry("scalar", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.name" } }
+			}`, "field 'test.v1.Query.name' of type 'string' can't be resolved by rpc 'test.v1.FooService.GetFoo'"),
+		Entry("list", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.foos" } }
+			}`, "field 'test.v1.Query.foos' of type 'repeated test.v1.Foo' can't be resolved by rpc 'test.v1.FooService.GetFoo'"),
+		Entry("duplicate", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.foo" } }
+			}
+			method {
+				name: "GetOtherFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.foo" } }
+			}`, "field 'Query.foo' is resolved by both rpc 'test.v1.FooService.GetFoo' and rpc 'test.v1.FooService.GetOtherFoo'"),
+		Entry("ignored", `options { [appsync.v1.field] { ignore: true } }`, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.foo" } }
+			}`, "field 'test.v1.Query.foo' is ignored, so it can't be resolved by rpc 'test.v1.FooService.GetFoo'"),
+		Entry("result field", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.name" result_field: "name" } }
+			}`, ""),
+		Entry("list result field", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Query"
+				options { [appsync.v1.method] { resolves: "Query.foos" result_field: "foos" } }
+			}`, ""),
+		Entry("result field not found", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.name" result_field: "title" } }
+			}`, "result field 'title' of rpc 'test.v1.FooService.GetFoo' not found in 'test.v1.Foo'"),
+		Entry("result field of other type", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Query"
+				options { [appsync.v1.method] { resolves: "Query.foos" result_field: "foo" } }
+			}`, "field 'test.v1.Query.foos' of type 'repeated test.v1.Foo' can't be resolved by rpc "+
+			"'test.v1.FooService.GetFoo', its result field 'foo' has type 'test.v1.Foo'"),
+		Entry("result field with other scalar", `options { [appsync.v1.field] { json: true } }`, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Filter"
+				options { [appsync.v1.method] { resolves: "Query.foo" result_field: "foo" } }
+			}`, "its result field 'foo' has type 'test.v1.Foo'"),
+		Entry("result field of connection", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Query"
+				options { [appsync.v1.method] { resolves: "Query.foos" result_field: "foos" connection {} } }
+			}`, "rpc 'test.v1.FooService.GetFoo' can't combine the result_field option with the connection option"),
+		Entry("input only", ``, `method {
+				name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+				options { [appsync.v1.method] { resolves: "Query.foo" resolves: "Filter.foo" } }
+			}`, "field 'test.v1.Filter.foo' is only used as input, so it can't be resolved by rpc 'test.v1.FooService.GetFoo'"),
+	)
+})
+
+var _ = Describe("validation rules", func() {
+	It("should reflect the rules of the example", func() {
+		Expect(nestedGraph).To(ContainSubstring(`"""Constraints: min_len: 1, max_len: 64, pattern: "^[a-z0-9-]+$" """` +
+			"\n\t\tid: String!\n"))
+		Expect(nestedGraph).To(ContainSubstring("notifyEmail: AWSEmail,"))
+		Expect(nestedGraph).To(ContainSubstring("\"\"\"\n\t\tConstraints: max_items: 5\n\t\t\n\t\t" +
+			"Constraints of the items: min_len: 1\n\t\t\"\"\"\n\t\ttags: [String!]!"))
+	})
+
+	DescribeTable("fields", func(field, msgOpts, exp string) {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			dependency: "validate/validate.proto"
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 `+field+` }
+				`+msgOpts+`
+			}
+			message_type { name: "Bar" field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL } }`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("type Query {\n\t" + exp + "\n}"))
+	},
+		Entry("string rules", `type: TYPE_STRING label: LABEL_OPTIONAL
+			options { [validate.rules] { string { min_len: 1 pattern: "^a" } } }`, ``,
+			`"""Constraints: min_len: 1, pattern: "^a" """`+"\n\tfoo: String!"),
+		Entry("quoted string", `type: TYPE_STRING label: LABEL_OPTIONAL
+			options { [validate.rules] { string { const: "a\"\"\"b" } } }`, ``,
+			`"""Constraints: const: "a\"\"\"b" """`+"\n\tfoo: String!"),
+		Entry("in", `type: TYPE_INT32 label: LABEL_OPTIONAL options { [validate.rules] { int32 { in: [1, 2] } } }`, ``,
+			`"""Constraints: in: [1, 2]"""`+"\n\tfoo: Int!"),
+		Entry("email", `type: TYPE_STRING label: LABEL_OPTIONAL options { [validate.rules] { string { email: true } } }`, ``,
+			"foo: AWSEmail!"),
+		Entry("uri", `type: TYPE_STRING label: LABEL_OPTIONAL options { [validate.rules] { string { uri: true max_len: 9 } } }`, ``,
+			`"""Constraints: max_len: 9"""`+"\n\tfoo: AWSURL!"),
+		Entry("ip", `type: TYPE_STRING label: LABEL_OPTIONAL options { [validate.rules] { string { ipv6: true } } }`, ``,
+			"foo: AWSIPAddress!"),
+		Entry("type option", `type: TYPE_STRING label: LABEL_OPTIONAL options {
+				[validate.rules] { string { email: true } }
+				[appsync.v1.field] { type: "ID" }
+			}`, ``, "foo: ID!"),
+		Entry("required", `type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL proto3_optional: true oneof_index: 0
+			options { [validate.rules] { message { required: true } } }`, `oneof_decl { name: "_foo" }`, "foo: Bar!"),
+		Entry("items", `type: TYPE_STRING label: LABEL_REPEATED
+			options { [validate.rules] { repeated { min_items: 1 items { string { email: true } } } } }`, ``,
+			`"""Constraints: min_items: 1"""`+"\n\tfoo: [AWSEmail!]!"),
+		Entry("disabled", `type: TYPE_STRING label: LABEL_OPTIONAL options { [validate.rules] { string { email: true } } }`,
+			`options { [validate.disabled]: true }`, "foo: String!"),
+	)
+})
+
+var _ = Describe("type names", func() {
+	It("should override type names with the name option", func() {
+		graph, res, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Query.Foo" label: LABEL_OPTIONAL }
+				nested_type {
+					name: "Foo"
+					field { name: "bar" json_name: "bar" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL }
+					options { [appsync.v1.message] { name: "Foo" } }
+				}
+			}
+			message_type { name: "Bar" }
+			service {
+				name: "BarService"
+				method {
+					name: "GetBar" input_type: ".test.v1.Bar" output_type: ".test.v1.Bar"
+					options { [appsync.v1.method] { resolves: "Foo.bar" } }
+				}
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("type Foo {\n\tbar: Bar!\n}"))
+		Expect(graph).To(ContainSubstring("foo: Foo!"))
+		Expect(res).To(ContainSubstring(`case "Foo.bar":`))
+	})
+
+	It("should name types like the Go types of their messages and enums", func() {
+		fd := parseFile(`
+			message_type {
+				name: "foo_bar"
+				nested_type { name: "baz_Qux2" nested_type { name: "_x" } enum_type { name: "dir_state" value { name: "UNKNOWN" number: 0 } } }
+			}
+			message_type { name: "HTTPServer" }
+			enum_type { name: "http_status" value { name: "OK" number: 0 } }`)
+		gp, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+			FileToGenerate: []string{fd.Path()}, ProtoFile: fileDescriptorProtos(fd, map[string]bool{}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		var names []string
+		enums := func(enums []*protogen.Enum) {
+			for _, enum := range enums {
+				Expect(appsyncjson.TypeName(enum.Desc)).To(Equal(enum.GoIdent.GoName))
+				names = append(names, enum.GoIdent.GoName)
+			}
+		}
+
+		var walk func([]*protogen.Message)
+		walk = func(msgs []*protogen.Message) {
+			for _, msg := range msgs {
+				Expect(appsyncjson.TypeName(msg.Desc)).To(Equal(msg.GoIdent.GoName))
+				names = append(names, msg.GoIdent.GoName)
+				walk(msg.Messages)
+				enums(msg.Enums)
+			}
+		}
+
+		walk(gp.FilesByPath[fd.Path()].Messages)
+		enums(gp.FilesByPath[fd.Path()].Enums)
+		Expect(names).To(Equal([]string{
+			"FooBar", "FooBarBaz_Qux2", "FooBarBaz_Qux2_XX", "FooBarBaz_Qux2DirState", "HTTPServer", "HttpStatus",
+		}))
+	})
+
+	DescribeTable("errors", func(txt, expErr string) {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field { name: "bar" json_name: "bar" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL }
+			}
+			message_type { name: "Foo" }`+txt))
+		Expect(err).To(MatchError(ContainSubstring(expErr)))
+	},
+		Entry("message collision", `message_type { name: "Bar" options { [appsync.v1.message] { name: "Foo" } } }`,
+			"graphql type 'Foo' for 'test.v1.Bar' collides with the type for 'test.v1.Foo'"),
+		Entry("root type collision", `message_type { name: "Bar" options { [appsync.v1.message] { name: "Query" } } }`,
+			"graphql type 'Query' for 'test.v1.Bar' collides with the type for 'test.v1.Query'"),
+		Entry("invalid name", `message_type { name: "Bar" options { [appsync.v1.message] { name: "Foo-Bar" } } }`,
+			"invalid name option 'Foo-Bar'"),
+	)
+
+	It("should detect collisions between enums and messages", func() {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			enum_type {
+				name: "Bar"
+				value { name: "BAR_UNSPECIFIED" number: 0 }
+				options { [appsync.v1.enum] { name: "Foo" } }
+			}
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field { name: "bar" json_name: "bar" number: 2 type: TYPE_ENUM type_name: ".test.v1.Bar" label: LABEL_OPTIONAL }
+			}
+			message_type { name: "Foo" }`))
+		Expect(err).To(MatchError(ContainSubstring("graphql type 'Foo' for 'test.v1.Bar' collides with the type for 'test.v1.Foo'")))
+	})
+})
+
+var _ = Describe("field names", func() {
+	It("should override field names with the name option", func() {
+		Expect(simpleGraph).To(ContainSubstring("type ListProfilesResponse {\n\tprofileIds: [ID!]!\n\tcount: Int!\n}"))
+	})
+
+	It("should use proto names when configured", func() {
+		graph, res, err := generate(&generator.Options{QueryMessageName: "Query", ProtoNames: true, ValidateScalars: true},
+			simplev1.File_examples_simple_v1_simple_proto)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("type ListProfilesResponse {\n\tprofile_ids: [ID!]!\n\tcount: Int!\n}"))
+		Expect(graph).To(ContainSubstring("decoration_case: EchoResponseDecorationCase"))
+		Expect(graph).To(ContainSubstring("echo_v2(message: String!,"))
+		Expect(res).To(ContainSubstring(`"Query.echo_v2"`))
+		Expect(res).To(ContainSubstring("(appsyncjson.UnmarshalOptions{ValidateScalars: true, UseProtoNames: true}).Unmarshal(args, &in)"))
+		Expect(res).To(ContainSubstring("(appsyncjson.MarshalOptions{UseProtoNames: true}).Marshal(resp.Msg)"))
+	})
+
+	DescribeTable("errors", func(opts, expErr string) {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+				field {
+					name: "bar" json_name: "bar" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL
+					options { [appsync.v1.field] { `+opts+` } }
+				}
+			}`))
+		Expect(err).To(MatchError(ContainSubstring(expErr)))
+	},
+		Entry("invalid name", `name: "foo.bar"`, "invalid name option 'foo.bar'"),
+		Entry("duplicate name", `name: "foo"`, "graphql field name 'foo' is used more than once in 'test.v1.Query'"),
+	)
+})
+
+var _ = Describe("empty messages", func() {
+	It("should expose the empty messages of the example", func() {
+		Expect(simpleGraph).To(ContainSubstring("type Acknowledgement {\n\t_empty: Boolean\n}"))
+		Expect(simpleGraph).To(ContainSubstring("enums: EnumKindsInput!): EchoKindsResponse!"))
+	})
+
+	DescribeTable("modes", func(mode generator.EmptyMessageMode, expGraph, unexpGraph, expRes string) {
+		graph, res, err := generate(&generator.Options{QueryMessageName: "Query", EmptyMessages: mode}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "ack" json_name: "ack" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Ack" label: LABEL_OPTIONAL }
+			}
+			message_type { name: "Ack" }
+			message_type {
+				name: "GetFooRequest"
+				field { name: "ack" json_name: "ack" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Ack" label: LABEL_OPTIONAL }
+				field { name: "bar" json_name: "bar" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: "Query.foo" } }
+				}
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("\tfoo(bar: String!): Foo!\n"))
+		Expect(graph).To(ContainSubstring(expGraph))
+		Expect(graph).ToNot(ContainSubstring(unexpGraph))
+		Expect(res).To(ContainSubstring(expRes))
+	},
+		Entry("placeholder field by default", generator.EmptyMessageMode(""),
+			"type Foo {\n\tack: Ack!\n}\ntype Ack {\n\t_empty: Boolean\n}", "AckInput", "appsyncjson.Marshal(resp.Msg)"),
+		Entry("boolean", generator.EmptyMessageBoolean,
+			"type Foo {\n\tack: Boolean\n}", "Ack", "(appsyncjson.MarshalOptions{EmptyAsBoolean: true}).Marshal(resp.Msg)"),
+	)
+
+	It("should omit input fields that only hold empty messages", func() {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "bar" json_name: "bar" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Bar"
+				field { name: "bar" json_name: "bar" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL }
+				field { name: "foo" json_name: "foo" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.Bar" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: "Query.foo" } }
+				}
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("\tfoo: Foo!\n"))
+		Expect(graph).ToNot(ContainSubstring("input"))
+	})
+
+	It("should resolve without arguments", func() {
+		data, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "echoKinds", []byte(`null`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(ContainSubstring(`"ack":null`))
+	})
+
+	It("should reject unsupported modes", func() {
+		_, err := generator.New(zap.NewNop(), &generator.Options{EmptyMessages: "foo"})
+		Expect(err).To(MatchError("unsupported empty message mode: 'foo'"))
+	})
+})
+
+var _ = Describe("descriptions", func() {
+	It("should generate descriptions from leading comments", func() {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field { name: "bar" json_name: "bar" number: 2 type: TYPE_ENUM type_name: ".test.v1.Bar" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "baz" json_name: "baz" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			enum_type {
+				name: "Bar"
+				value { name: "BAR_UNSPECIFIED" number: 0 }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.Foo" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: "Query.foo" } }
+				}
+			}
+			source_code_info {
+				location { path: [4, 0] span: [0, 0, 0] leading_comments: " Query is the root\n" }
+				location { path: [4, 0, 2, 0] span: [0, 0, 0] leading_comments: " replaced by the method comment\n" }
+				location { path: [4, 0, 2, 1] span: [0, 0, 0] leading_comments: " bar field\n" }
+				location { path: [4, 1, 2, 0] span: [0, 0, 0] leading_comments: " baz field spans\n multiple lines\n" }
+				location { path: [5, 0] span: [0, 0, 0] leading_comments: " Bar \"\"\"enum\"\"\"\n" }
+				location { path: [5, 0, 2, 0] span: [0, 0, 0] leading_comments: " unspecified value\n" }
+				location { path: [6, 0, 2, 0] span: [0, 0, 0] leading_comments: " GetFoo returns foo\n" }
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("\"\"\"Query is the root\"\"\"\ntype Query {"))
+		Expect(graph).To(ContainSubstring("\t\"\"\"GetFoo returns foo\"\"\"\n\tfoo(\n" +
+			"\t\t\"\"\"\n\t\tbaz field spans\n\t\tmultiple lines\n\t\t\"\"\"\n\t\tbaz: String!\n\t): Foo!"))
+		Expect(graph).To(ContainSubstring("\t\"\"\"bar field\"\"\"\n\tbar: Bar!"))
+		Expect(graph).To(ContainSubstring(`"""Bar \"""enum\""" """` + "\nenum Bar {\n\t\"\"\"unspecified value\"\"\"\n"))
+	})
+})
+
+var _ = Describe("deprecation", func() {
+	It("should mark deprecated fields, arguments and enum values", func() {
+		Expect(simpleGraph).To(ContainSubstring(`): EchoResponse! @deprecated(reason: "use echo instead")`))
+		Expect(simpleGraph).To(ContainSubstring("leader: String! @deprecated, decoration"))
+		Expect(simpleGraph).To(ContainSubstring("\tlatestVersion: String! @deprecated\n"))
+		Expect(simpleGraph).To(ContainSubstring(`MOOD_JOYFUL @deprecated(reason: "use MOOD_HAPPY")`))
+	})
+
+	It("should take the reason from the comments", func() {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field {
+					name: "foo" json_name: "foo" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL
+					options { deprecated: true }
+				}
+			}
+			source_code_info {
+				location {
+					path: [4, 0, 2, 0] span: [0, 0, 0]
+					leading_comments: " foo field\n\n Deprecated: use\n bar instead.\n\n More about foo.\n"
+				}
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring(`foo: String! @deprecated(reason: "use bar instead.")`))
+	})
+
+	It("should mark deprecated input fields and arguments without changing their type", func() {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "FooRequest"
+				field {
+					name: "bar" json_name: "bar" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL
+					options { deprecated: true [appsync.v1.field] { deprecation_reason: "use baz" } }
+				}
+				field {
+					name: "baz" json_name: "baz" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Baz" label: LABEL_OPTIONAL
+				}
+			}
+			message_type {
+				name: "Baz"
+				field {
+					name: "qux" json_name: "qux" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL
+					options { deprecated: true }
+				}
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.FooRequest" output_type: ".test.v1.Query"
+					options { [appsync.v1.method] { resolves: "Query.foo" result_field: "foo" } }
+				}
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring(`foo(bar: String! @deprecated(reason: "use baz"), baz: BazInput!): String!`))
+		Expect(graph).To(ContainSubstring("input BazInput {\n\tqux: String! @deprecated\n}"))
+	})
+
+	It("should keep resolving deprecated fields", func() {
+		data, err := simplev1.ResolveSimpleService(context.Background(), echoKinds{}, "Query", "echoV2",
+			[]byte(`{"message":"foo","leader":"bar"}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"message":"foo","decorationCase":null}`))
+	})
+})
+
+var _ = Describe("auth", func() {
+	const file = `
+			message_type {
+				name: "Query"
+				field {
+					name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL
+					%s
+				}
+				%s
+			}
+			message_type {
+				name: "Foo"
+				field { name: "bar" json_name: "bar" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.Foo" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: "Query.foo" %s } }
+				}
+			}`
+
+	DescribeTable("directives", func(opts generator.Options, fldOpts, msgOpts, metOpts, exp string) {
+		opts.QueryMessageName = "Query"
+		graph, _, err := generate(&opts, parseFile(fmt.Sprintf(file, fldOpts, msgOpts, metOpts)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring(exp))
+	},
+		Entry("none", generator.Options{}, ``, ``, ``, "type Query {\n\tfoo(bar: String!): Foo!\n}"),
+		Entry("message", generator.Options{}, ``, `options { [appsync.v1.message] { auth { api_key: true iam: true } } }`, ``,
+			"type Query @aws_api_key @aws_iam {"),
+		Entry("field", generator.Options{}, `options { [appsync.v1.field] { auth { oidc: true lambda: true } } }`, ``, ``,
+			"foo(bar: String!): Foo! @aws_oidc @aws_lambda"),
+		Entry("method", generator.Options{}, ``, ``, `auth { cognito_groups: ["a", "b"] }`,
+			`foo(bar: String!): Foo! @aws_cognito_user_pools(cognito_groups: ["a","b"])`),
+		Entry("default", generator.Options{DefaultAuth: generator.AuthAPIKey}, ``,
+			`options { [appsync.v1.message] { auth { cognito_user_pools: true } } }`, ``,
+			"type Foo @aws_api_key {"),
+		Entry("default overridden", generator.Options{DefaultAuth: generator.AuthAPIKey}, ``,
+			`options { [appsync.v1.message] { auth { cognito_user_pools: true } } }`, ``,
+			"type Query @aws_cognito_user_pools {"),
+	)
+
+	DescribeTable("errors", func(fldOpts, msgOpts, metOpts, expErr string) {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(fmt.Sprintf(file, fldOpts, msgOpts, metOpts)))
+		Expect(err).To(MatchError(ContainSubstring(expErr)))
+	},
+		Entry("no modes", ``, `options { [appsync.v1.message] { auth { api_key: false } } }`, ``,
+			"invalid auth option: auth option must allow at least one authorization mode"),
+		Entry("groups without user pools", `options { [appsync.v1.field] { auth { cognito_user_pools: false cognito_groups: "a" } } }`, ``, ``,
+			"invalid auth option: cognito groups require cognito user pools to be allowed"),
+		Entry("field and method", `options { [appsync.v1.field] { auth { iam: true } } }`, ``, `auth { iam: true }`,
+			"auth is configured on both the field and the method 'test.v1.FooService.GetFoo' that resolves it"),
+		Entry("method", ``, ``, `auth { }`,
+			"invalid auth option of method 'test.v1.FooService.GetFoo': auth option must allow at least one authorization mode"),
+	)
+
+	It("should only authorize referenced messages and map entries through their own auth options", func() {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field {
+					name: "labels" json_name: "labels" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Query.LabelsEntry" label: LABEL_REPEATED
+				}
+				field {
+					name: "tags" json_name: "tags" number: 3 type: TYPE_MESSAGE type_name: ".test.v1.Query.TagsEntry" label: LABEL_REPEATED
+					options { [appsync.v1.field] { auth { lambda: true } } }
+				}
+				nested_type {
+					name: "LabelsEntry"
+					field { name: "key" json_name: "key" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+					field { name: "value" json_name: "value" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+					options { map_entry: true }
+				}
+				nested_type {
+					name: "TagsEntry"
+					field { name: "key" json_name: "key" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+					field { name: "value" json_name: "value" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+					options { map_entry: true }
+				}
+				options { [appsync.v1.message] { auth { api_key: true } } }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "bar" json_name: "bar" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("type Query @aws_api_key {"))
+		Expect(graph).To(ContainSubstring("type Foo {"))
+		Expect(graph).To(ContainSubstring("type QueryLabelsEntry @aws_api_key {"))
+		Expect(graph).To(ContainSubstring("type QueryTagsEntry @aws_lambda {"))
+	})
+
+	DescribeTable("input only", func(fldOpts, msgOpts, expErr string) {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "bar" json_name: "bar" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Bar"
+				field { name: "bar" json_name: "bar" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "GetFooRequest"
+				field {
+					name: "bar" json_name: "bar" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL
+					`+fldOpts+`
+				}
+				`+msgOpts+`
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: "Query.foo" } }
+				}
+			}`))
+		Expect(err).To(MatchError(ContainSubstring(expErr)))
+	},
+		Entry("field", `options { [appsync.v1.field] { auth { iam: true } } }`, ``,
+			"auth option of 'test.v1.GetFooRequest.bar' has no effect, since it is only used as input"),
+		Entry("message", ``, `options { [appsync.v1.message] { auth { iam: true } } }`,
+			"auth option of 'test.v1.GetFooRequest' has no effect, since it is only used as input"),
+	)
+
+	It("should reject unsupported default modes", func() {
+		_, err := generator.New(zap.NewNop(), &generator.Options{DefaultAuth: "foo"})
+		Expect(err).To(MatchError("unsupported default auth mode: 'foo'"))
+	})
+})
+
+var _ = Describe("subscriptions", func() {
+	It("should generate the subscriptions of the example", func() {
+		Expect(nestedGraph).To(ContainSubstring("postCreated(id: String): CreatePostResponse @aws_subscribe(mutations: [\"createPost\"])"))
+	})
+
+	DescribeTable("options", func(subOpts, expField, expErr string) {
+		graph, _, err := generate(&generator.Options{
+			QueryMessageName: "Query", MutationMessageName: "Mutation", SubscriptionMessageName: "Subscription",
+		}, parseFile(`
+			message_type {
+				name: "Mutation"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field { name: "bar" json_name: "bar" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Subscription"
+				field {
+					name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL
+					options { [appsync.v1.field] { subscribe { `+subOpts+` } } }
+				}
+			}
+			message_type {
+				name: "Foo"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field { name: "bar" json_name: "bar" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}`))
+		if expErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(graph).To(ContainSubstring("type Subscription {\n\t" + expField + "\n}"))
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+		}
+	},
+		Entry("mutation", `mutations: "foo"`, `foo: Foo @aws_subscribe(mutations: ["foo"])`, ""),
+		Entry("arguments", `mutations: "foo" arguments: "bar"`, `foo(bar: String): Foo @aws_subscribe(mutations: ["foo"])`, ""),
+		Entry("no mutations", ``, ``, "subscribe option must list at least one mutation"),
+		Entry("unknown mutation", `mutations: "baz"`, ``, "mutation field 'baz' not found"),
+		Entry("different type", `mutations: "bar"`, ``, "mutation field 'bar' is not of the same type as the subscription field"),
+		Entry("unknown argument", `mutations: "foo" arguments: "baz"`, ``, "argument field 'baz' not found in 'test.v1.Foo'"),
+		Entry("message argument", `mutations: "foo" arguments: "foo"`, ``, "argument field 'foo' must be a scalar or enum field"),
+	)
+
+	It("should only allow fields of the subscription message to subscribe", func() {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query", SubscriptionMessageName: "Subscription"}, parseFile(`
+			message_type {
+				name: "Query"
+				field {
+					name: "foo" json_name: "foo" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL
+					options { [appsync.v1.field] { subscribe { mutations: "foo" } } }
+				}
+			}`))
+		Expect(err).To(MatchError(ContainSubstring("only fields of the subscription message 'Subscription' can subscribe to mutations")))
+	})
+})
+
+var _ = Describe("connections", func() {
+	It("should generate relay connections", func() {
+		Expect(nestedGraph).To(ContainSubstring("listPosts(first: Int, after: String): PostConnection!"))
+		Expect(nestedGraph).To(ContainSubstring("type PostConnection @aws_api_key @aws_iam {\n\tedges: [PostEdge!]!\n\tpageInfo: PageInfo!\n}"))
+		Expect(nestedGraph).To(ContainSubstring("type PostEdge @aws_api_key @aws_iam {\n\tnode: Post!\n\tcursor: String!\n}"))
+		Expect(nestedGraph).To(ContainSubstring("type PageInfo @aws_api_key @aws_iam {\n\thasNextPage: Boolean!\n" +
+			"\t\"\"\"Always false, connections only page forward with the first and after arguments.\"\"\"\n\thasPreviousPage: Boolean!\n" +
+			"\tstartCursor: String\n\tendCursor: String\n}"))
+		Expect(nestedGraph).ToNot(ContainSubstring("ListPostsResponse"))
+		Expect(nestedRes).To(ContainSubstring(".UnmarshalConnection(args, &in, conn)"))
+		Expect(nestedRes).To(ContainSubstring(".MarshalConnection(resp.Msg, conn, page)"))
+	})
+
+	DescribeTable("type prefix", func(connOpts, expErr string) {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foos" json_name: "foos" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.ListFoosResponse" label: LABEL_OPTIONAL }
+				field { name: "info" json_name: "info" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.PageInfo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "PageInfo"
+				field { name: "total" json_name: "total" number: 1 type: TYPE_INT32 label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "ListFoosRequest"
+				field { name: "page_size" json_name: "pageSize" number: 1 type: TYPE_INT32 label: LABEL_OPTIONAL }
+				field { name: "page_token" json_name: "pageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "ListFoosResponse"
+				field { name: "foos" json_name: "foos" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_REPEATED }
+				field { name: "next_page_token" json_name: "nextPageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "ListFoos" input_type: ".test.v1.ListFoosRequest" output_type: ".test.v1.ListFoosResponse"
+					options { [appsync.v1.method] { resolves: "Query.foos" connection { `+connOpts+` } } }
+				}
+			}`))
+		if expErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+			return
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring("foos(first: Int, after: String): RelayFooConnection!"))
+		Expect(graph).To(ContainSubstring("type RelayFooConnection {\n\tedges: [RelayFooEdge!]!\n\tpageInfo: RelayPageInfo!\n}"))
+		Expect(graph).To(ContainSubstring("type PageInfo {\n\ttotal: Int!\n}"))
+		Expect(graph).To(ContainSubstring("type RelayPageInfo {\n\thasNextPage: Boolean!"))
+	},
+		Entry("prefixed", `type_prefix: "Relay"`, ""),
+		Entry("colliding", ``, "graphql type 'PageInfo' for 'test.v1.PageInfo' collides with the type for "+
+			"'appsync.v1.Connection', configure a type prefix for the connection"),
+		Entry("invalid", `type_prefix: "1"`, "invalid type prefix '1'"),
+	)
+
+	It("should authorize the connection types like the nodes they list", func() {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foos" json_name: "foos" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.ListFoosResponse" label: LABEL_OPTIONAL }
+				field { name: "bars" json_name: "bars" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.ListBarsResponse" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+				options { [appsync.v1.message] { auth { iam: true cognito_groups: ["a"] } } }
+			}
+			message_type {
+				name: "Bar"
+				field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+				options { [appsync.v1.message] { auth { api_key: true cognito_groups: ["a", "b"] } } }
+			}
+			message_type {
+				name: "ListRequest"
+				field { name: "page_size" json_name: "pageSize" number: 1 type: TYPE_INT32 label: LABEL_OPTIONAL }
+				field { name: "page_token" json_name: "pageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "ListFoosResponse"
+				field { name: "foos" json_name: "foos" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_REPEATED }
+				field { name: "next_page_token" json_name: "nextPageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "ListBarsResponse"
+				field { name: "bars" json_name: "bars" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_REPEATED }
+				field { name: "next_page_token" json_name: "nextPageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "ListFoos" input_type: ".test.v1.ListRequest" output_type: ".test.v1.ListFoosResponse"
+					options { [appsync.v1.method] { resolves: "Query.foos" connection { } } }
+				}
+				method {
+					name: "ListBars" input_type: ".test.v1.ListRequest" output_type: ".test.v1.ListBarsResponse"
+					options { [appsync.v1.method] { resolves: "Query.bars" connection { } } }
+				}
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(ContainSubstring(`type FooConnection @aws_iam @aws_cognito_user_pools(cognito_groups: ["a"]) {`))
+		Expect(graph).To(ContainSubstring(`type FooEdge @aws_iam @aws_cognito_user_pools(cognito_groups: ["a"]) {`))
+		Expect(graph).To(ContainSubstring(`type BarConnection @aws_api_key @aws_cognito_user_pools(cognito_groups: ["a","b"]) {`))
+		Expect(graph).To(ContainSubstring(`type PageInfo @aws_iam @aws_cognito_user_pools(cognito_groups: ["a","b"]) @aws_api_key {`))
+	})
+
+	DescribeTable("errors", func(reqFields, respFields, connOpts, expErr string) {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.FooResponse" label: LABEL_OPTIONAL }
+			}
+			message_type { name: "Foo" }
+			message_type { name: "FooRequest" `+reqFields+` }
+			message_type { name: "FooResponse" `+respFields+` }
+			service {
+				name: "FooService"
+				method {
+					name: "ListFoo" input_type: ".test.v1.FooRequest" output_type: ".test.v1.FooResponse"
+					options { [appsync.v1.method] { resolves: "Query.foo" connection { `+connOpts+` } } }
+				}
+			}`))
+		if expErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+		}
+	},
+		Entry("valid",
+			`field { name: "page_size" json_name: "pageSize" number: 1 type: TYPE_UINT64 label: LABEL_OPTIONAL }
+			field { name: "page_token" json_name: "pageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "foos" json_name: "foos" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_REPEATED }
+			field { name: "next_page_token" json_name: "nextPageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			``, ""),
+		Entry("no page size", ``, ``, ``, "page size field 'page_size' not found in 'test.v1.FooRequest'"),
+		Entry("string page size",
+			`field { name: "size" json_name: "size" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`, ``,
+			`page_size: "size"`, "page size field 'size' must be an integer field"),
+		Entry("integer page token",
+			`field { name: "page_size" json_name: "pageSize" number: 1 type: TYPE_INT32 label: LABEL_OPTIONAL }
+			field { name: "page_token" json_name: "pageToken" number: 2 type: TYPE_INT32 label: LABEL_OPTIONAL }`, ``,
+			``, "page token field 'page_token' must be a string field"),
+		Entry("no items",
+			`field { name: "page_size" json_name: "pageSize" number: 1 type: TYPE_INT32 label: LABEL_OPTIONAL }
+			field { name: "page_token" json_name: "pageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "next_page_token" json_name: "nextPageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			``, "response 'test.v1.FooResponse' has no repeated message field to take the items from"),
+		Entry("scalar items",
+			`field { name: "page_size" json_name: "pageSize" number: 1 type: TYPE_INT32 label: LABEL_OPTIONAL }
+			field { name: "page_token" json_name: "pageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "ids" json_name: "ids" number: 1 type: TYPE_STRING label: LABEL_REPEATED }
+			field { name: "next_page_token" json_name: "nextPageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`items: "ids"`, "items field 'ids' must be a repeated message field"),
+		Entry("colliding argument",
+			`field { name: "page_size" json_name: "pageSize" number: 1 type: TYPE_INT32 label: LABEL_OPTIONAL }
+			field { name: "page_token" json_name: "pageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			field { name: "first" json_name: "first" number: 3 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "foos" json_name: "foos" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_REPEATED }
+			field { name: "next_page_token" json_name: "nextPageToken" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			``, "argument 'first' collides with the pagination arguments of the connection"),
+	)
+})
+
+var _ = Describe("nodes", func() {
+	It("should generate the relay nodes of the example", func() {
+		Expect(nestedGraph).To(ContainSubstring("type Post implements Node @aws_api_key @aws_iam {\n\tid: ID!\n"))
+		Expect(nestedRes).To(ContainSubstring(`case "examples.nested.v1.Post":`))
+		Expect(nestedRes).To(ContainSubstring(`.MarshalAs(resp.Msg.GetPost(), "Post")`))
+	})
+
+	It("should import the packages of the resolving code when only nodes are fetched", func() {
+		_, res, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+				options { [appsync.v1.message] { node { fetch: "FooService.GetFoo" } } }
+			}
+			message_type {
+				name: "GetFooRequest"
+				field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method { name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.Foo" }
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(ContainSubstring("import (\n\tcontext \"context\"\n\tfmt \"fmt\"\n" +
+			"\tconnect_go \"github.com/bufbuild/connect-go\"\n" +
+			"\tappsyncjson \"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson\"\n)"))
+		Expect(res).To(ContainSubstring("if connect_go.CodeOf(err) == connect_go.CodeNotFound {"))
+	})
+
+	DescribeTable("options", func(nodeOpts, fooFields, reqFields, respFields, expErr string) {
+		graph, res, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo" `+fooFields+`
+				options { [appsync.v1.message] { node { `+nodeOpts+` } } }
+			}
+			message_type { name: "GetFooRequest" `+reqFields+` }
+			message_type { name: "GetFooResponse" `+respFields+` }
+			service {
+				name: "FooService"
+				method { name: "GetFoo" input_type: ".test.v1.GetFooRequest" output_type: ".test.v1.GetFooResponse" }
+			}`))
+		if expErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(graph).To(ContainSubstring("interface Node {\n\tid: ID!\n}"))
+			Expect(graph).To(ContainSubstring("type Foo implements Node {\n\tid: ID!\n}"))
+			Expect(graph).To(ContainSubstring("type Query {\n\tfoo: Foo!\n\t\"\"\"fetches an object by its globally unique id\"\"\"\n" +
+				"\tnode(id: ID!): Node\n}"))
+			Expect(res).To(ContainSubstring(`"Query.node",`))
+			Expect(res).To(ContainSubstring(`case "test.v1.Foo":`))
+			Expect(res).To(ContainSubstring(`.MarshalAs(resp.Msg.GetFoo(), "Foo")`))
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+		}
+	},
+		Entry("valid", `fetch: "FooService.GetFoo"`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }`,
+			""),
+		Entry("no id", `fetch: "FooService.GetFoo"`, ``, ``, ``, "invalid node option: id field 'id' not found in 'test.v1.Foo'"),
+		Entry("integer id", `id: "num" fetch: "FooService.GetFoo"`,
+			`field { name: "num" json_name: "num" number: 1 type: TYPE_INT32 label: LABEL_OPTIONAL }`, ``, ``,
+			"invalid node option: id field 'num' must be a string field"),
+		Entry("no fetch method", ``,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`, ``, ``,
+			"invalid node option: fetch method '' not found"),
+		Entry("no id in request", `fetch: "FooService.GetFoo"`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`, ``, ``,
+			"invalid node option: request of fetch method 'FooService.GetFoo' has no string field 'id'"),
+		Entry("optional id in request", `fetch: "FooService.GetFoo"`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL proto3_optional: true oneof_index: 0 }
+			oneof_decl { name: "_id" }`, ``,
+			"invalid node option: id field 'id' of the request of fetch method 'FooService.GetFoo' must not be optional or part of a oneof"),
+		Entry("oneof id in request", `fetch: "FooService.GetFoo"`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL oneof_index: 0 }
+			oneof_decl { name: "by" }`, ``,
+			"invalid node option: id field 'id' of the request of fetch method 'FooService.GetFoo' must not be optional or part of a oneof"),
+		Entry("node in a oneof of the response", `fetch: "FooService.GetFoo"`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL oneof_index: 0 }
+			oneof_decl { name: "result" }`,
+			""),
+		Entry("no node in response", `fetch: "FooService.GetFoo"`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`, ``,
+			"invalid node option: response of fetch method 'FooService.GetFoo' is not 'test.v1.Foo' and has no field of its type"),
+	)
+})
+
+var _ = Describe("interfaces", func() {
+	It("should generate interfaces and their implementations", func() {
+		Expect(simpleGraph).To(ContainSubstring("interface Notification {\n\ttext: String!\n}"))
+		Expect(simpleGraph).To(ContainSubstring("type EmailNotification implements Notification {\n\ttext: String!\n\taddress: AWSEmail!\n}"))
+		Expect(simpleGraph).To(ContainSubstring("type SmsNotification implements Notification {\n\ttext: String!\n\tphone: AWSPhone!\n}"))
+		Expect(simpleGraph).To(ContainSubstring("notifications: [Notification!]!"))
+		Expect(simpleGraph).ToNot(ContainSubstring("kindCase"))
+	})
+
+	DescribeTable("errors", func(ifaceFields, implFields, inputFields, expErr string) {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field { name: "bar" json_name: "bar" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL }
+				field { name: "baz" json_name: "baz" number: 3 type: TYPE_MESSAGE type_name: ".test.v1.Baz" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo" `+ifaceFields+`
+				options { [appsync.v1.message] { interface: true } }
+			}
+			message_type { name: "Bar" `+implFields+` }
+			message_type { name: "Baz" `+inputFields+` }
+			service {
+				name: "FooService"
+				method {
+					name: "GetBaz" input_type: ".test.v1.Baz" output_type: ".test.v1.Baz"
+					options { [appsync.v1.method] { resolves: "Query.baz" } }
+				}
+			}`))
+		if expErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expErr)))
+		}
+	},
+		Entry("valid",
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL proto3_optional: true oneof_index: 1 }
+			field { name: "bar" json_name: "bar" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL oneof_index: 0 }
+			oneof_decl { name: "kind" } oneof_decl { name: "_name" }`,
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`, ``, ""),
+		Entry("no fields",
+			`field { name: "bar" json_name: "bar" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL oneof_index: 0 }
+			oneof_decl { name: "kind" }`,
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			``, "interface 'test.v1.Foo' must declare at least one field"),
+		Entry("no oneof",
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			``, "interface 'test.v1.Foo' must have a oneof with the messages that implement it"),
+		Entry("scalar member",
+			`field { name: "id" json_name: "id" number: 2 type: TYPE_STRING label: LABEL_OPTIONAL }
+			field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL oneof_index: 0 }
+			oneof_decl { name: "kind" }`,
+			``, ``, "member 'name' of interface 'test.v1.Foo' must be a message field"),
+		Entry("missing field",
+			`field { name: "id" json_name: "id" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			field { name: "bar" json_name: "bar" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL oneof_index: 0 }
+			oneof_decl { name: "kind" }`,
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			``, "'Bar' implements 'Foo' but has no field 'id'"),
+		Entry("incompatible field",
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			field { name: "bar" json_name: "bar" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL oneof_index: 0 }
+			oneof_decl { name: "kind" }`,
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL proto3_optional: true oneof_index: 0 }
+			oneof_decl { name: "_name" }`, ``,
+			"field 'name' of 'Bar' has type 'String', which is not compatible with type 'String!' of interface 'Foo'"),
+		Entry("as input",
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			field { name: "bar" json_name: "bar" number: 2 type: TYPE_MESSAGE type_name: ".test.v1.Bar" label: LABEL_OPTIONAL oneof_index: 0 }
+			oneof_decl { name: "kind" }`,
+			`field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }`,
+			`field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }`,
+			"interface 'test.v1.Foo' can't be used as input"),
+	)
+})
+
+var _ = Describe("root types", func() {
+	const file = `
+			message_type {
+				name: "RootQuery"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Mutation"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "bar" json_name: "bar" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.Foo" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: "RootQuery.foo" } }
+				}
+			}`
+
+	It("should declare custom root types in a schema block", func() {
+		graph, res, err := generate(&generator.Options{
+			QueryMessageName: "RootQuery", MutationMessageName: "Mutation", SubscriptionMessageName: "Subscription",
+		}, parseFile(file))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(HavePrefix("schema {\n\tquery: RootQuery\n\tmutation: Mutation\n}\n"))
+		Expect(graph).To(ContainSubstring("type RootQuery {"))
+		Expect(res).To(ContainSubstring(`case "RootQuery.foo":`))
+	})
+
+	It("should not declare default root types", func() {
+		Expect(simpleGraph).ToNot(ContainSubstring("schema {"))
+	})
+
+	It("should rename the root types when configured", func() {
+		graph, res, err := generate(&generator.Options{
+			QueryMessageName: "RootQuery", MutationMessageName: "Mutation", SubscriptionMessageName: "Subscription",
+			RenameRoots: true,
+		}, parseFile(file))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).ToNot(ContainSubstring("schema {"))
+		Expect(graph).To(ContainSubstring("type Query {"))
+		Expect(graph).ToNot(ContainSubstring("RootQuery"))
+		Expect(res).To(ContainSubstring(`case "Query.foo":`))
+	})
+})
+
+var _ = Describe("cross-package types", func() {
+	It("should import resolver messages from other packages", func() {
+		Expect(simpleGraph).To(ContainSubstring("nextPage(cursor: String!, size: Int!): PageInfo!"))
+		Expect(simpleRes).To(ContainSubstring(`v1 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/pagination/v1"`))
+		Expect(simpleRes).To(ContainSubstring(
+			"NextPage(context.Context, *connect_go.Request[v1.PageRequest]) (*connect_go.Response[v1.PageInfo], error)"))
+		Expect(strings.Count(simpleRes, "import (")).To(Equal(1))
+		Expect(simpleRes).To(ContainSubstring("var in v1.PageRequest"))
+		Expect(simpleRes).To(ContainSubstring("var in EchoRequest"))
+	})
+})
+
+var _ = Describe("diagnostics", func() {
+	const file = `
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field {
+					name: "bar" json_name: "bar" number: 2 type: TYPE_INT32 label: LABEL_OPTIONAL
+					options { [appsync.v1.field] { default: "abc" } }
+				}
+				field {
+					name: "baz" json_name: "baz" number: 3 type: TYPE_STRING label: LABEL_OPTIONAL
+					options { [appsync.v1.field] { type: "AWSFoo" } }
+				}
+			}
+			message_type {
+				name: "Foo"
+				field { name: "name" json_name: "name" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.Foo" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: ["Query.foo", "Query.nope"] } }
+				}
+				method {
+					name: "GetOther" input_type: ".test.v1.Foo" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: "Query.foo" } }
+				}
+			}`
+
+	It("should report all problems of a file together, located by its source info", func() {
+		_, _, err := generate(&generator.Options{
+			QueryMessageName: "Query", MutationMessageName: "Mutation", SubscriptionMessageName: "Subscription",
+		}, parseFile(file+`
+			source_code_info {
+				location { path: [4, 0, 2, 1] span: [5, 2, 40] }
+				location { path: [4, 0, 2, 2] span: [6, 2, 40] }
+				location { path: [6, 0, 2, 0] span: [12, 2, 14, 3] }
+				location { path: [6, 0, 2, 1] span: [15, 2, 17, 3] }
+			}`))
+
+		var diags generator.Diagnostics
+		Expect(errors.As(err, &diags)).To(BeTrue())
+		Expect(diags).To(HaveLen(4))
+		Expect(diags[0].Error()).To(HavePrefix("test/v1/test.proto:6:3: invalid default option: invalid default 'abc'"))
+		Expect(diags[1].Error()).To(HavePrefix("test/v1/test.proto:7:3: invalid type option:"))
+		Expect(diags[2]).To(MatchError("test/v1/test.proto:13:3: FooService.GetFoo resolves field 'Query.nope' " +
+			"but it was not found under the root messages (Query, Mutation or Subscription)"))
+		Expect(diags[3]).To(MatchError("test/v1/test.proto:16:3: invalid resolves option: field 'Query.foo' " +
+			"is resolved by both rpc 'test.v1.FooService.GetFoo' and rpc 'test.v1.FooService.GetOther'"))
+	})
+
+	It("should report problems without a line if the file has no source info", func() {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(file))
+		Expect(err).To(MatchError(ContainSubstring("test/v1/test.proto: invalid default option:")))
+		Expect(err).To(MatchError(ContainSubstring("\ntest/v1/test.proto: FooService.GetFoo resolves field 'Query.nope'")))
+	})
+})
+
+var _ = Describe("schema validation", func() {
+	It("should validate the example schemas with the AppSync scalars and directives", func() {
+		// the shared setup fails if the examples don't validate, these check that they use both
+		Expect(simpleGraph).To(ContainSubstring("timestampValue: AWSDateTime!"))
+		Expect(nestedGraph).To(ContainSubstring(`@aws_subscribe(mutations: ["createPost"])`))
+	})
+
+	It("should reject a schema that redeclares an AppSync scalar", func() {
+		_, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				options { [appsync.v1.message] { name: "AWSDate" } }
+				field { name: "bar" json_name: "bar" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			service {
+				name: "FooService"
+				method {
+					name: "GetFoo" input_type: ".test.v1.Foo" output_type: ".test.v1.Foo"
+					options { [appsync.v1.method] { resolves: "Query.foo" } }
+				}
+			}`))
+		Expect(err).To(MatchError("test/v1/test.proto: " +
+			"invalid graphql schema at 'AWSDate' (generated from 'test.v1.Foo'): Cannot redeclare type AWSDate."))
+	})
+})
+
+var _ = Describe("deterministic output", func() {
+	DescribeTable("should generate the same files on every run", func(fd protoreflect.FileDescriptor) {
+		opts := &generator.Options{
+			QueryMessageName: "Query", MutationMessageName: "Mutation", SubscriptionMessageName: "Subscription",
+		}
+
+		graph0, res0, err := generate(opts, fd)
+		Expect(err).ToNot(HaveOccurred())
+		for i := 0; i < 10; i++ {
+			graph, res, err := generate(opts, fd)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(graph).To(Equal(graph0))
+			Expect(res).To(Equal(res0))
+		}
+	},
+		Entry("simple", simplev1.File_examples_simple_v1_simple_proto),
+		Entry("nested", nestedv1.File_examples_nested_v1_nested_proto),
+	)
+
+	It("should follow the declaration order of the proto file", func() {
+		Expect(simpleGraph).To(MatchRegexp(`(?s)type Query \{.*input PaginationInput \{.*type ListProfilesResponse \{.*` +
+			`input EchoRequestDecorationInput \{.*type EchoResponse \{.*type EchoKindsResponse \{`))
+		Expect(simpleRes).To(ContainSubstring(`"Query.echo", "Query.echoV2", "Query.listProfiles", "Query.latestVersion",`))
+	})
+
+	It("should follow the source positions when the file has source info", func() {
+		graph, _, err := generate(&generator.Options{QueryMessageName: "Query"}, parseFile(`
+			message_type {
+				name: "Query"
+				field { name: "foo" json_name: "foo" number: 1 type: TYPE_MESSAGE type_name: ".test.v1.Foo" label: LABEL_OPTIONAL }
+				field { name: "bar" json_name: "bar" number: 2 type: TYPE_ENUM type_name: ".test.v1.Bar" label: LABEL_OPTIONAL }
+			}
+			message_type {
+				name: "Foo"
+				field { name: "baz" json_name: "baz" number: 1 type: TYPE_STRING label: LABEL_OPTIONAL }
+			}
+			enum_type {
+				name: "Bar"
+				value { name: "BAR_UNSPECIFIED" number: 0 }
+			}
+			source_code_info {
+				location { path: [4, 0] span: [0, 0, 3, 1] }
+				location { path: [4, 1] span: [8, 0, 10, 1] }
+				location { path: [5, 0] span: [4, 0, 7, 1] }
+			}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(graph).To(MatchRegexp(`(?s)type Query \{.*enum Bar \{.*type Foo \{`))
+	})
+})
+
+// echoKinds implements the simple service by echoing the kinds
+type echoKinds struct {
+	simplev1connect.UnimplementedSimpleServiceHandler
+}
+
+func (echoKinds) EchoKinds(
+	ctx context.Context, req *connect.Request[simplev1.EchoKindsRequest],
+) (*connect.Response[simplev1.EchoKindsResponse], error) {
+	return connect.NewResponse(&simplev1.EchoKindsResponse{
+		Kinds: req.Msg.Kinds, WellKnown: req.Msg.WellKnown, Maps: req.Msg.Maps, Aws: req.Msg.Aws, Enums: req.Msg.Enums,
+		Ack: req.Msg.Ack,
+	}), nil
+}
+
+func (echoKinds) Echo(
+	ctx context.Context, req *connect.Request[simplev1.EchoRequest],
+) (*connect.Response[simplev1.EchoResponse], error) {
+	return connect.NewResponse(&simplev1.EchoResponse{Message: req.Msg.Message}), nil
+}
+
+func (echoKinds) Version(
+	ctx context.Context, req *connect.Request[simplev1.VersionRequest],
+) (*connect.Response[simplev1.VersionResponse], error) {
+	return connect.NewResponse(&simplev1.VersionResponse{Version: "v1.2.3"}), nil
+}
+
+func (echoKinds) ListNotifications(
+	ctx context.Context, req *connect.Request[simplev1.ListNotificationsRequest],
+) (*connect.Response[simplev1.ListNotificationsResponse], error) {
+	return connect.NewResponse(&simplev1.ListNotificationsResponse{Notifications: []*simplev1.Notification{{
+		Kind: &simplev1.Notification_Sms{Sms: &simplev1.SmsNotification{Text: fmt.Sprintf("limit %d", req.Msg.Limit)}},
+	}}}), nil
+}
+
+// generate runs the generator for the file descriptor and returns the graphql schema and the resolver code
+func generate(opts *generator.Options, fd protoreflect.FileDescriptor) (graph, res string, err error) {
+	req := &pluginpb.CodeGeneratorRequest{FileToGenerate: []string{fd.Path()}}
+	req.ProtoFile = fileDescriptorProtos(fd, map[string]bool{})
+
+	gp, err := protogen.Options{}.New(req)
+	if err != nil {
+		return "", "", err
+	}
+
+	gen, err := generator.New(zap.NewNop(), opts)
+	if err != nil {
+		return "", "", err
+	}
+
+	pf := gp.FilesByPath[fd.Path()]
+	resf := gp.NewGeneratedFile(pf.GeneratedFilenamePrefix+".res.go", pf.GoImportPath)
+
+	var graphw bytes.Buffer
+	if err = gen.NewTarget(pf).Generate(&graphw, resf); err != nil {
+		return "", "", err
+	}
+
+	resb, err := resf.Content()
+	if err != nil {
+		return "", "", err
+	}
+
+	return graphw.String(), string(resb), nil
+}
+
+// parseFile parses a (partial) file descriptor in the protobuf text format, for a file that imports our options
+func parseFile(txt string) protoreflect.FileDescriptor {
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("test/v1/test.proto"),
+		Package:    proto.String("test.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"appsync/v1/appsync.proto"},
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/test/v1;testv1")},
+	}
+
+	var part descriptorpb.FileDescriptorProto
+	Expect(prototext.Unmarshal([]byte(txt), &part)).To(Succeed())
+	proto.Merge(fdp, &part)
+
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	Expect(err).ToNot(HaveOccurred())
+	return fd
+}
+
+// fileDescriptorProtos returns the file descriptor protos for fd, preceded by its (transitive) imports
+func fileDescriptorProtos(fd protoreflect.FileDescriptor, seen map[string]bool) (fds []*descriptorpb.FileDescriptorProto) {
+	if seen[fd.Path()] {
+		return nil
+	}
+
+	seen[fd.Path()] = true
+	for i := 0; i < fd.Imports().Len(); i++ {
+		fds = append(fds, fileDescriptorProtos(fd.Imports().Get(i).FileDescriptor, seen)...)
+	}
+
+	return append(fds, protodesc.ToFileDescriptorProto(fd))
+}
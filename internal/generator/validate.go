@@ -0,0 +1,138 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/envoyproxy/protoc-gen-validate/validate"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// validatedScalars are the (AWS) scalars that string fields are declared as when they have the matching
+// protoc-gen-validate rule, by the name of the rule.
+var validatedScalars = []struct{ rule, scalar string }{
+	{"email", "AWSEmail"},
+	{"uri", "AWSURL"},
+	{"ip", "AWSIPAddress"},
+	{"ipv4", "AWSIPAddress"},
+	{"ipv6", "AWSIPAddress"},
+}
+
+// fieldRules returns the protoc-gen-validate rules of a field, or nil if it has none or if validation is
+// disabled for its message.
+func fieldRules(fld *protogen.Field) *validate.FieldRules {
+	mopts, _ := fld.Parent.Desc.Options().(*descriptorpb.MessageOptions)
+	if proto.GetExtension(mopts, validate.E_Disabled).(bool) || proto.GetExtension(mopts, validate.E_Ignored).(bool) {
+		return nil
+	}
+
+	fopts, _ := fld.Desc.Options().(*descriptorpb.FieldOptions)
+	rules, _ := proto.GetExtension(fopts, validate.E_Rules).(*validate.FieldRules)
+	return rules
+}
+
+// generateValidation reflects the protoc-gen-validate rules of a field in its definition. Required
+// messages are non-null and strings that must be an email, uri or ip address are declared as the
+// matching AWS scalar. The other rules are documented in the field's description, AppSync doesn't
+// allow custom directives that could express them.
+func generateValidation(def *ast.FieldDefinition, fld *protogen.Field) {
+	rules := fieldRules(fld)
+	if rules == nil {
+		return
+	}
+
+	if rules.GetMessage().GetRequired() && fld.Desc.Cardinality() != protoreflect.Repeated {
+		def.Type.NonNull = true
+	}
+
+	// the rules of lists are described separately from the rules of their items
+	kind, prefix := kindRules(rules), "Constraints: "
+	if items := rules.GetRepeated().GetItems(); items != nil && fld.Desc.IsList() {
+		if desc := describeRules(kind, nil); desc != "" {
+			def.Description = appendDescription(def.Description, prefix+desc)
+		}
+
+		kind, prefix = kindRules(items), "Constraints of the items: "
+	}
+
+	skip := map[protoreflect.Name]bool{}
+	if _, ok := kind.(*validate.StringRules); ok {
+		msg := kind.ProtoReflect()
+		for _, vs := range validatedScalars {
+			if fd := msg.Descriptor().Fields().ByName(protoreflect.Name(vs.rule)); msg.Get(fd).Bool() {
+				def.Type.NamedType, skip[fd.Name()] = vs.scalar, true
+				break
+			}
+		}
+	}
+
+	if desc := describeRules(kind, skip); desc != "" {
+		def.Description = appendDescription(def.Description, prefix+desc)
+	}
+}
+
+// kindRules returns the set member of the rules' "type" oneof, i.e. the string rules, or nil if none is set
+func kindRules(rules *validate.FieldRules) proto.Message {
+	msg := rules.ProtoReflect()
+	if fd := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("type")); fd != nil {
+		return msg.Get(fd).Message().Interface()
+	}
+
+	return nil
+}
+
+// describeRules describes the rules that are set, except for those in 'skip', i.e: `min_len: 3, pattern:
+// "^[a-z]+$"`. Nested rules, like those of map keys or of timestamps, are not described.
+func describeRules(kind proto.Message, skip map[protoreflect.Name]bool) string {
+	if kind == nil {
+		return ""
+	}
+
+	var parts []string
+	rules := kind.ProtoReflect()
+	fields := rules.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !rules.Has(fd) || skip[fd.Name()] || fd.Kind() == protoreflect.MessageKind {
+			continue
+		}
+
+		if !fd.IsList() {
+			parts = append(parts, fmt.Sprintf("%s: %s", fd.Name(), describeValue(fd, rules.Get(fd))))
+			continue
+		}
+
+		list := rules.Get(fd).List()
+		vals := make([]string, list.Len())
+		for j := range vals {
+			vals[j] = describeValue(fd, list.Get(j))
+		}
+
+		parts = append(parts, fmt.Sprintf("%s: [%s]", fd.Name(), strings.Join(vals, ", ")))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// describeValue describes the value of a rule
+func describeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return strconv.Quote(v.String()) // escaped, or quotes could end the description
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%q", v.Bytes())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+
+		return fmt.Sprint(v.Enum())
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,12 @@
+package generator
+
+import (
+	"github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// isWellKnown returns whether the message is a well-known type that maps onto a graphql scalar
+func isWellKnown(msg *protogen.Message) bool {
+	_, ok := appsyncjson.WellKnown(msg.Desc)
+	return ok
+}
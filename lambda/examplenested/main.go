@@ -3,7 +3,10 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"sort"
+	"strconv"
 
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/bufbuild/connect-go"
@@ -79,6 +82,62 @@ func (r Resolver) Posts(
 	}), nil
 }
 
+// ListPosts lists posts in pages, the page token is the offset of the page
+func (r Resolver) ListPosts(
+	ctx context.Context,
+	req *connect.Request[nestedv1.ListPostsRequest],
+) (resp *connect.Response[nestedv1.ListPostsResponse], err error) {
+	ids := lo.Keys(r.posts)
+	sort.Strings(ids)
+
+	var offset int
+	if req.Msg.PageToken != "" {
+		if offset, err = strconv.Atoi(req.Msg.PageToken); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	end := len(ids)
+	if req.Msg.PageSize > 0 && offset+int(req.Msg.PageSize) < end {
+		end = offset + int(req.Msg.PageSize)
+	}
+
+	out := &nestedv1.ListPostsResponse{}
+	for _, id := range ids[lo.Min([]int{offset, end}):end] {
+		out.Posts = append(out.Posts, r.posts[id])
+	}
+
+	if end < len(ids) {
+		out.NextPageToken = strconv.Itoa(end)
+	}
+
+	return connect.NewResponse(out), nil
+}
+
+// GetPost fetches a single post
+func (r Resolver) GetPost(
+	ctx context.Context,
+	req *connect.Request[nestedv1.GetPostRequest],
+) (resp *connect.Response[nestedv1.GetPostResponse], err error) {
+	post, ok := r.posts[req.Msg.Id]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("post '%s' not found", req.Msg.Id))
+	}
+
+	return connect.NewResponse(&nestedv1.GetPostResponse{Post: post}), nil
+}
+
+// CreatePost creates a post
+func (r Resolver) CreatePost(
+	ctx context.Context,
+	req *connect.Request[nestedv1.CreatePostRequest],
+) (resp *connect.Response[nestedv1.CreatePostResponse], err error) {
+	post := &nestedv1.Post{Id: req.Msg.Id}
+	r.posts[post.Id] = post
+
+	return connect.NewResponse(&nestedv1.CreatePostResponse{Id: post.Id, Post: post}), nil
+}
+
 // lambda entry point
 func main() {
 	r := Resolver{
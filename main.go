@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 
@@ -13,7 +14,12 @@ import (
 var (
 	queryMessage        = flag.String("query_message", "Query", "name of the message that describes the top-level Query type")
 	mutationMessage     = flag.String("mutation_message", "Mutation", "name of the message that describes the top-level Mutation type")
-	subscriptionMessage = flag.String("subscription_message", "Mutation", "name of the message that describes the top-level Mutation type")
+	subscriptionMessage = flag.String("subscription_message", "Subscription", "name of the message that describes the top-level Subscription type")
+	renameRoots         = flag.Bool("rename_roots", false, "name the root types Query, Mutation and Subscription, regardless of the names of their messages")
+	validateScalars     = flag.Bool("validate_scalars", false, "validate arguments against the format of their (AWS) scalar type in the resolvers")
+	emptyMessages       = flag.String("empty_messages", "field", "expose fields of empty messages as an object with an '_empty' field ('field') or as a Boolean ('boolean')")
+	defaultAuth         = flag.String("default_auth", "", "authorization mode of types without auth option: api_key, iam, cognito_user_pools, oidc or lambda")
+	protoNames          = flag.Bool("proto_names", false, "name graphql fields after the proto field names instead of their json names")
 )
 
 func main() {
@@ -31,6 +37,11 @@ func main() {
 			QueryMessageName:        *queryMessage,
 			MutationMessageName:     *mutationMessage,
 			SubscriptionMessageName: *subscriptionMessage,
+			RenameRoots:             *renameRoots,
+			ValidateScalars:         *validateScalars,
+			ProtoNames:              *protoNames,
+			EmptyMessages:           generator.EmptyMessageMode(*emptyMessages),
+			DefaultAuth:             generator.AuthMode(*defaultAuth),
 		}
 
 		gen, err := generator.New(logs, opts)
@@ -38,6 +49,7 @@ func main() {
 			return fmt.Errorf("failed to initialize generator: %w", err)
 		}
 
+		var diags generator.Diagnostics
 		for _, name := range gp.Request.FileToGenerate {
 			pf := gp.FilesByPath[name]
 			if len(pf.Services) < 1 {
@@ -49,7 +61,9 @@ func main() {
 				gp.NewGeneratedFile(fmt.Sprintf("%s.res.go", pf.GeneratedFilenamePrefix), pf.GoImportPath),
 				gp.NewGeneratedFile(fmt.Sprintf("%s.graphql", pf.GeneratedFilenamePrefix), pf.GoImportPath)
 
-			if err := gen.NewTarget(pf).Generate(graphf, resolvef); err != nil {
+			if err := gen.NewTarget(pf).Generate(graphf, resolvef); errors.As(err, &diags) {
+				return diags // each problem is already located in the proto file
+			} else if err != nil {
 				return fmt.Errorf("failed to generate for '%s': %w", *pf.Proto.Name, err)
 			}
 		}
@@ -29,6 +29,20 @@ type MethodOptions struct {
 
 	// resolves designates an rpc method as the resolver for a <Message>.<some_field>
 	Resolves []string `protobuf:"bytes,3,rep,name=resolves" json:"resolves,omitempty"`
+	// deprecation_reason is the reason for deprecating the fields that the method resolves, if the
+	// method is marked as deprecated.
+	DeprecationReason *string `protobuf:"bytes,4,opt,name=deprecation_reason,json=deprecationReason" json:"deprecation_reason,omitempty"`
+	// auth configures the authorization modes that allow access to the fields that the method resolves
+	Auth *Auth `protobuf:"bytes,5,opt,name=auth" json:"auth,omitempty"`
+	// connection makes the fields that the method resolves a Relay cursor connection of the items in its
+	// paginated response: https://relay.dev/graphql/connections.htm. Only forward pagination is supported,
+	// the fields take the "first" and "after" arguments but not "last" and "before", and the hasPreviousPage
+	// of the page info is always false.
+	Connection *Connection `protobuf:"bytes,6,opt,name=connection" json:"connection,omitempty"`
+	// result_field names the field of the response that holds the value of the fields that the method
+	// resolves, instead of the whole response. It must have the same type as the fields it resolves, which
+	// allows methods to resolve scalar and list fields.
+	ResultField *string `protobuf:"bytes,7,opt,name=result_field,json=resultField" json:"result_field,omitempty"`
 }
 
 func (x *MethodOptions) Reset() {
@@ -70,6 +84,131 @@ func (x *MethodOptions) GetResolves() []string {
 	return nil
 }
 
+func (x *MethodOptions) GetDeprecationReason() string {
+	if x != nil && x.DeprecationReason != nil {
+		return *x.DeprecationReason
+	}
+	return ""
+}
+
+func (x *MethodOptions) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetConnection() *Connection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetResultField() string {
+	if x != nil && x.ResultField != nil {
+		return *x.ResultField
+	}
+	return ""
+}
+
+// Connection configures how the request and response of a paginated rpc method map onto a Relay cursor
+// connection. The "first" and "after" arguments are translated into the page size and page token of the
+// request, and the edges and page info are built from the items and next page token of the response.
+type Connection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// items is the (proto) name of the repeated message field in the response that holds the nodes. It
+	// defaults to the only repeated message field of the response.
+	Items *string `protobuf:"bytes,1,opt,name=items" json:"items,omitempty"`
+	// page_size is the (proto) name of the integer field in the request that limits the number of items
+	PageSize *string `protobuf:"bytes,2,opt,name=page_size,json=pageSize,def=page_size" json:"page_size,omitempty"`
+	// page_token is the (proto) name of the string field in the request that selects the page
+	PageToken *string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,def=page_token" json:"page_token,omitempty"`
+	// next_page_token is the (proto) name of the string field in the response that holds the token of the
+	// next page, it is empty on the last page.
+	NextPageToken *string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,def=next_page_token" json:"next_page_token,omitempty"`
+	// type_prefix is put in front of the names of the generated types: <prefix><Node>Connection,
+	// <prefix><Node>Edge and <prefix>PageInfo. It avoids collisions with types of the same name.
+	TypePrefix *string `protobuf:"bytes,5,opt,name=type_prefix,json=typePrefix" json:"type_prefix,omitempty"`
+}
+
+// Default values for Connection fields.
+const (
+	Default_Connection_PageSize      = string("page_size")
+	Default_Connection_PageToken     = string("page_token")
+	Default_Connection_NextPageToken = string("next_page_token")
+)
+
+func (x *Connection) Reset() {
+	*x = Connection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_appsync_v1_appsync_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connection) ProtoMessage() {}
+
+func (x *Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_appsync_v1_appsync_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connection.ProtoReflect.Descriptor instead.
+func (*Connection) Descriptor() ([]byte, []int) {
+	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Connection) GetItems() string {
+	if x != nil && x.Items != nil {
+		return *x.Items
+	}
+	return ""
+}
+
+func (x *Connection) GetPageSize() string {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return Default_Connection_PageSize
+}
+
+func (x *Connection) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return Default_Connection_PageToken
+}
+
+func (x *Connection) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return Default_Connection_NextPageToken
+}
+
+func (x *Connection) GetTypePrefix() string {
+	if x != nil && x.TypePrefix != nil {
+		return *x.TypePrefix
+	}
+	return ""
+}
+
 // FieldOptions presents options to configure fields to interact with protobuf powered rpc
 type FieldOptions struct {
 	state         protoimpl.MessageState
@@ -78,12 +217,34 @@ type FieldOptions struct {
 
 	// ignore a field from being part of generated graphql schema
 	Ignore *bool `protobuf:"varint,1,opt,name=ignore" json:"ignore,omitempty"`
+	// json exposes a message or map field as an AWSJSON scalar that holds its protojson encoding, instead of
+	// generating (entry) types for it.
+	Json *bool `protobuf:"varint,2,opt,name=json" json:"json,omitempty"`
+	// type overrides the graphql type of a scalar field, i.e: "ID", "AWSEmail", "AWSURL", "AWSPhone",
+	// "AWSIPAddress", "AWSDate", "AWSTime", "AWSDateTime" or "AWSTimestamp". It must be compatible with
+	// the field's protobuf type.
+	Type *string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// name overrides the name of the graphql field, which defaults to the json name of the field
+	Name *string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	// deprecation_reason is the reason for deprecating the field, if it is marked as deprecated. Input
+	// fields and the arguments they become are marked with @deprecated as well, and keep their type.
+	DeprecationReason *string `protobuf:"bytes,5,opt,name=deprecation_reason,json=deprecationReason" json:"deprecation_reason,omitempty"`
+	// auth configures the authorization modes that allow access to the field, overriding the modes of
+	// its type. The entries of a map field get the same modes. It can't be set on fields that are only
+	// used as input.
+	Auth *Auth `protobuf:"bytes,6,opt,name=auth" json:"auth,omitempty"`
+	// subscribe configures a field of the subscription message to be triggered by mutations
+	Subscribe *Subscribe `protobuf:"bytes,7,opt,name=subscribe" json:"subscribe,omitempty"`
+	// default is the value of an input field, or of the argument it becomes, when it is omitted. It is
+	// written as the plain value for scalar fields, i.e: "10", "true" or "hello", and as the name of the
+	// value for enum fields. Only singular scalar and enum fields can have a default.
+	Default *string `protobuf:"bytes,8,opt,name=default" json:"default,omitempty"`
 }
 
 func (x *FieldOptions) Reset() {
 	*x = FieldOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_appsync_v1_appsync_proto_msgTypes[1]
+		mi := &file_appsync_v1_appsync_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -96,7 +257,7 @@ func (x *FieldOptions) String() string {
 func (*FieldOptions) ProtoMessage() {}
 
 func (x *FieldOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_appsync_v1_appsync_proto_msgTypes[1]
+	mi := &file_appsync_v1_appsync_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +270,7 @@ func (x *FieldOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldOptions.ProtoReflect.Descriptor instead.
 func (*FieldOptions) Descriptor() ([]byte, []int) {
-	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{1}
+	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *FieldOptions) GetIgnore() bool {
@@ -119,6 +280,466 @@ func (x *FieldOptions) GetIgnore() bool {
 	return false
 }
 
+func (x *FieldOptions) GetJson() bool {
+	if x != nil && x.Json != nil {
+		return *x.Json
+	}
+	return false
+}
+
+func (x *FieldOptions) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetDeprecationReason() string {
+	if x != nil && x.DeprecationReason != nil {
+		return *x.DeprecationReason
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *FieldOptions) GetSubscribe() *Subscribe {
+	if x != nil {
+		return x.Subscribe
+	}
+	return nil
+}
+
+func (x *FieldOptions) GetDefault() string {
+	if x != nil && x.Default != nil {
+		return *x.Default
+	}
+	return ""
+}
+
+// Subscribe configures a subscription field, through the AppSync @aws_subscribe directive
+type Subscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mutations lists the (proto) names of the fields on the mutation message that trigger the subscription.
+	// They must be of the same type as the subscription field.
+	Mutations []string `protobuf:"bytes,1,rep,name=mutations" json:"mutations,omitempty"`
+	// arguments lists the (proto) names of fields on the subscription's message that become arguments of
+	// the subscription field. AppSync only sends data to subscribers if it matches the provided arguments.
+	Arguments []string `protobuf:"bytes,2,rep,name=arguments" json:"arguments,omitempty"`
+}
+
+func (x *Subscribe) Reset() {
+	*x = Subscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_appsync_v1_appsync_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscribe) ProtoMessage() {}
+
+func (x *Subscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_appsync_v1_appsync_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscribe.ProtoReflect.Descriptor instead.
+func (*Subscribe) Descriptor() ([]byte, []int) {
+	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Subscribe) GetMutations() []string {
+	if x != nil {
+		return x.Mutations
+	}
+	return nil
+}
+
+func (x *Subscribe) GetArguments() []string {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+// EnumOptions presents options to configure how enums are exposed in the graphql schema
+type EnumOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// omit_zero hides the zero value from the graphql enum. Fields of the enum become nullable and the zero
+	// value is exposed as null instead.
+	OmitZero *bool `protobuf:"varint,1,opt,name=omit_zero,json=omitZero" json:"omit_zero,omitempty"`
+	// name overrides the name of the graphql enum type, which defaults to the name of the Go type
+	Name *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (x *EnumOptions) Reset() {
+	*x = EnumOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_appsync_v1_appsync_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumOptions) ProtoMessage() {}
+
+func (x *EnumOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_appsync_v1_appsync_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumOptions.ProtoReflect.Descriptor instead.
+func (*EnumOptions) Descriptor() ([]byte, []int) {
+	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnumOptions) GetOmitZero() bool {
+	if x != nil && x.OmitZero != nil {
+		return *x.OmitZero
+	}
+	return false
+}
+
+func (x *EnumOptions) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+// MessageOptions presents options to configure how messages are exposed in the graphql schema
+type MessageOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name overrides the name of the graphql object type, which defaults to the name of the Go type. The
+	// input object type has the same name with an "Input" suffix.
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// auth configures the authorization modes that allow access to the object type, and to the entries
+	// of its map fields. Messages that it references are not covered, they need their own auth option. It
+	// can't be set on messages that are only used as input.
+	Auth *Auth `protobuf:"bytes,2,opt,name=auth" json:"auth,omitempty"`
+	// node marks the message as a Relay node: https://relay.dev/graphql/objectidentification.htm. Its
+	// object type implements the Node interface and can be fetched through the "node" query field.
+	Node *Node `protobuf:"bytes,3,opt,name=node" json:"node,omitempty"`
+	// interface exposes the message as a graphql interface. Its fields declare the fields of the interface,
+	// and the message fields of its oneof are the messages that implement it. Values are encoded as the
+	// member of the oneof that is set, with the name of its type in the "__typename" field.
+	Interface *bool `protobuf:"varint,4,opt,name=interface" json:"interface,omitempty"`
+}
+
+func (x *MessageOptions) Reset() {
+	*x = MessageOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_appsync_v1_appsync_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageOptions) ProtoMessage() {}
+
+func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_appsync_v1_appsync_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MessageOptions) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *MessageOptions) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetInterface() bool {
+	if x != nil && x.Interface != nil {
+		return *x.Interface
+	}
+	return false
+}
+
+// Node configures how a message is identified and fetched as a Relay node. The id field is exposed as
+// "id: ID!" and holds a globally unique id that encodes the name of the message and its (proto) id.
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the (proto) name of the string field that identifies the message
+	Id *string `protobuf:"bytes,1,opt,name=id,def=id" json:"id,omitempty"`
+	// fetch is the name of the rpc method that fetches the message by its id, as <Service>.<Method> of a
+	// service in the same file. Its request must have a string field with the same name as the id field
+	// and its response must be the message, or have a field of its type.
+	Fetch *string `protobuf:"bytes,2,opt,name=fetch" json:"fetch,omitempty"`
+}
+
+// Default values for Node fields.
+const (
+	Default_Node_Id = string("id")
+)
+
+func (x *Node) Reset() {
+	*x = Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_appsync_v1_appsync_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_appsync_v1_appsync_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Node) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return Default_Node_Id
+}
+
+func (x *Node) GetFetch() string {
+	if x != nil && x.Fetch != nil {
+		return *x.Fetch
+	}
+	return ""
+}
+
+// EnumValueOptions presents options to configure how enum values are exposed in the graphql schema
+type EnumValueOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deprecation_reason is the reason for deprecating the value, if it is marked as deprecated
+	DeprecationReason *string `protobuf:"bytes,1,opt,name=deprecation_reason,json=deprecationReason" json:"deprecation_reason,omitempty"`
+}
+
+func (x *EnumValueOptions) Reset() {
+	*x = EnumValueOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_appsync_v1_appsync_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumValueOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumValueOptions) ProtoMessage() {}
+
+func (x *EnumValueOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_appsync_v1_appsync_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumValueOptions.ProtoReflect.Descriptor instead.
+func (*EnumValueOptions) Descriptor() ([]byte, []int) {
+	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EnumValueOptions) GetDeprecationReason() string {
+	if x != nil && x.DeprecationReason != nil {
+		return *x.DeprecationReason
+	}
+	return ""
+}
+
+// Auth configures the AppSync authorization modes that allow access to a type or field, through the
+// AppSync authorization directives: https://docs.aws.amazon.com/appsync/latest/devguide/security-authz.html
+type Auth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// api_key allows access with an API key: @aws_api_key
+	ApiKey *bool `protobuf:"varint,1,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	// iam allows access with IAM credentials: @aws_iam
+	Iam *bool `protobuf:"varint,2,opt,name=iam" json:"iam,omitempty"`
+	// cognito_user_pools allows access to users of a Cognito user pool: @aws_cognito_user_pools
+	CognitoUserPools *bool `protobuf:"varint,3,opt,name=cognito_user_pools,json=cognitoUserPools" json:"cognito_user_pools,omitempty"`
+	// cognito_groups restricts Cognito user pool access to users in these groups, it implies cognito_user_pools
+	CognitoGroups []string `protobuf:"bytes,4,rep,name=cognito_groups,json=cognitoGroups" json:"cognito_groups,omitempty"`
+	// oidc allows access with an OpenID Connect token: @aws_oidc
+	Oidc *bool `protobuf:"varint,5,opt,name=oidc" json:"oidc,omitempty"`
+	// lambda allows access through a Lambda authorizer: @aws_lambda
+	Lambda *bool `protobuf:"varint,6,opt,name=lambda" json:"lambda,omitempty"`
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_appsync_v1_appsync_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_appsync_v1_appsync_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_appsync_v1_appsync_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Auth) GetApiKey() bool {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
+	return false
+}
+
+func (x *Auth) GetIam() bool {
+	if x != nil && x.Iam != nil {
+		return *x.Iam
+	}
+	return false
+}
+
+func (x *Auth) GetCognitoUserPools() bool {
+	if x != nil && x.CognitoUserPools != nil {
+		return *x.CognitoUserPools
+	}
+	return false
+}
+
+func (x *Auth) GetCognitoGroups() []string {
+	if x != nil {
+		return x.CognitoGroups
+	}
+	return nil
+}
+
+func (x *Auth) GetOidc() bool {
+	if x != nil && x.Oidc != nil {
+		return *x.Oidc
+	}
+	return false
+}
+
+func (x *Auth) GetLambda() bool {
+	if x != nil && x.Lambda != nil {
+		return *x.Lambda
+	}
+	return false
+}
+
 var file_appsync_v1_appsync_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
@@ -136,6 +757,30 @@ var file_appsync_v1_appsync_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,1098,opt,name=field",
 		Filename:      "appsync/v1/appsync.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
+		ExtensionType: (*EnumOptions)(nil),
+		Field:         1097,
+		Name:          "appsync.v1.enum",
+		Tag:           "bytes,1097,opt,name=enum",
+		Filename:      "appsync/v1/appsync.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*MessageOptions)(nil),
+		Field:         1096,
+		Name:          "appsync.v1.message",
+		Tag:           "bytes,1096,opt,name=message",
+		Filename:      "appsync/v1/appsync.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*EnumValueOptions)(nil),
+		Field:         1095,
+		Name:          "appsync.v1.enum_value",
+		Tag:           "bytes,1095,opt,name=enum_value",
+		Filename:      "appsync/v1/appsync.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MethodOptions.
@@ -150,6 +795,24 @@ var (
 	E_Field = &file_appsync_v1_appsync_proto_extTypes[1]
 )
 
+// Extension fields to descriptorpb.EnumOptions.
+var (
+	// optional appsync.v1.EnumOptions enum = 1097;
+	E_Enum = &file_appsync_v1_appsync_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional appsync.v1.MessageOptions message = 1096;
+	E_Message = &file_appsync_v1_appsync_proto_extTypes[3]
+)
+
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// optional appsync.v1.EnumValueOptions enum_value = 1095;
+	E_EnumValue = &file_appsync_v1_appsync_proto_extTypes[4]
+)
+
 var File_appsync_v1_appsync_proto protoreflect.FileDescriptor
 
 var file_appsync_v1_appsync_proto_rawDesc = []byte{
@@ -157,34 +820,124 @@ var file_appsync_v1_appsync_proto_rawDesc = []byte{
 	0x73, 0x79, 0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x61, 0x70, 0x70, 0x73,
 	0x79, 0x6e, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x3a, 0x52, 0x0a,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xcb, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x3a, 0x4e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xca, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x42, 0xaf, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e,
-	0x63, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x41, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x72, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x67,
-	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2f,
-	0x76, 0x31, 0x3b, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41,
-	0x58, 0x58, 0xaa, 0x02, 0x0a, 0x41, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x0a, 0x41, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x41,
-	0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x41, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x3a,
-	0x3a, 0x56, 0x31,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdb, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x79,
+	0x70, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x86, 0x02, 0x0a, 0x0c, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a,
+	0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70,
+	0x73, 0x79, 0x6e, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x09, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x22, 0x47, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3e, 0x0a, 0x0b, 0x45, 0x6e,
+	0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6d, 0x69,
+	0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x6d,
+	0x69, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x30, 0x0a, 0x04, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x3a,
+	0x02, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x65, 0x74, 0x63, 0x68, 0x22, 0x41, 0x0a,
+	0x10, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xb2, 0x01, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x69, 0x61, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x67, 0x6e,
+	0x69, 0x74, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x69, 0x64,
+	0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c,
+	0x61, 0x6d, 0x62, 0x64, 0x61, 0x3a, 0x52, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xcb, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x4e, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xca, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79,
+	0x6e, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4a, 0x0a, 0x04, 0x65, 0x6e, 0x75,
+	0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xc9, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x04, 0x65, 0x6e, 0x75, 0x6d, 0x3a, 0x56, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xc8, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79,
+	0x6e, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x5f, 0x0a,
+	0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc7,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0xaf,
+	0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x76,
+	0x31, 0x42, 0x0c, 0x41, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72,
+	0x65, 0x77, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x67, 0x6f, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x76, 0x31, 0x3b,
+	0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa,
+	0x02, 0x0a, 0x41, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x41,
+	0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x41, 0x70, 0x70, 0x73,
+	0x79, 0x6e, 0x63, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0b, 0x41, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x3a, 0x3a, 0x56, 0x31,
 }
 
 var (
@@ -199,23 +952,45 @@ func file_appsync_v1_appsync_proto_rawDescGZIP() []byte {
 	return file_appsync_v1_appsync_proto_rawDescData
 }
 
-var file_appsync_v1_appsync_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_appsync_v1_appsync_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_appsync_v1_appsync_proto_goTypes = []interface{}{
-	(*MethodOptions)(nil),              // 0: appsync.v1.MethodOptions
-	(*FieldOptions)(nil),               // 1: appsync.v1.FieldOptions
-	(*descriptorpb.MethodOptions)(nil), // 2: google.protobuf.MethodOptions
-	(*descriptorpb.FieldOptions)(nil),  // 3: google.protobuf.FieldOptions
+	(*MethodOptions)(nil),                 // 0: appsync.v1.MethodOptions
+	(*Connection)(nil),                    // 1: appsync.v1.Connection
+	(*FieldOptions)(nil),                  // 2: appsync.v1.FieldOptions
+	(*Subscribe)(nil),                     // 3: appsync.v1.Subscribe
+	(*EnumOptions)(nil),                   // 4: appsync.v1.EnumOptions
+	(*MessageOptions)(nil),                // 5: appsync.v1.MessageOptions
+	(*Node)(nil),                          // 6: appsync.v1.Node
+	(*EnumValueOptions)(nil),              // 7: appsync.v1.EnumValueOptions
+	(*Auth)(nil),                          // 8: appsync.v1.Auth
+	(*descriptorpb.MethodOptions)(nil),    // 9: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),     // 10: google.protobuf.FieldOptions
+	(*descriptorpb.EnumOptions)(nil),      // 11: google.protobuf.EnumOptions
+	(*descriptorpb.MessageOptions)(nil),   // 12: google.protobuf.MessageOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 13: google.protobuf.EnumValueOptions
 }
 var file_appsync_v1_appsync_proto_depIdxs = []int32{
-	2, // 0: appsync.v1.method:extendee -> google.protobuf.MethodOptions
-	3, // 1: appsync.v1.field:extendee -> google.protobuf.FieldOptions
-	0, // 2: appsync.v1.method:type_name -> appsync.v1.MethodOptions
-	1, // 3: appsync.v1.field:type_name -> appsync.v1.FieldOptions
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	2, // [2:4] is the sub-list for extension type_name
-	0, // [0:2] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8,  // 0: appsync.v1.MethodOptions.auth:type_name -> appsync.v1.Auth
+	1,  // 1: appsync.v1.MethodOptions.connection:type_name -> appsync.v1.Connection
+	8,  // 2: appsync.v1.FieldOptions.auth:type_name -> appsync.v1.Auth
+	3,  // 3: appsync.v1.FieldOptions.subscribe:type_name -> appsync.v1.Subscribe
+	8,  // 4: appsync.v1.MessageOptions.auth:type_name -> appsync.v1.Auth
+	6,  // 5: appsync.v1.MessageOptions.node:type_name -> appsync.v1.Node
+	9,  // 6: appsync.v1.method:extendee -> google.protobuf.MethodOptions
+	10, // 7: appsync.v1.field:extendee -> google.protobuf.FieldOptions
+	11, // 8: appsync.v1.enum:extendee -> google.protobuf.EnumOptions
+	12, // 9: appsync.v1.message:extendee -> google.protobuf.MessageOptions
+	13, // 10: appsync.v1.enum_value:extendee -> google.protobuf.EnumValueOptions
+	0,  // 11: appsync.v1.method:type_name -> appsync.v1.MethodOptions
+	2,  // 12: appsync.v1.field:type_name -> appsync.v1.FieldOptions
+	4,  // 13: appsync.v1.enum:type_name -> appsync.v1.EnumOptions
+	5,  // 14: appsync.v1.message:type_name -> appsync.v1.MessageOptions
+	7,  // 15: appsync.v1.enum_value:type_name -> appsync.v1.EnumValueOptions
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	11, // [11:16] is the sub-list for extension type_name
+	6,  // [6:11] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_appsync_v1_appsync_proto_init() }
@@ -237,6 +1012,18 @@ func file_appsync_v1_appsync_proto_init() {
 			}
 		}
 		file_appsync_v1_appsync_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Connection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_appsync_v1_appsync_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FieldOptions); i {
 			case 0:
 				return &v.state
@@ -248,6 +1035,78 @@ func file_appsync_v1_appsync_proto_init() {
 				return nil
 			}
 		}
+		file_appsync_v1_appsync_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscribe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_appsync_v1_appsync_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_appsync_v1_appsync_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_appsync_v1_appsync_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_appsync_v1_appsync_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumValueOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_appsync_v1_appsync_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Auth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -255,8 +1114,8 @@ func file_appsync_v1_appsync_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_appsync_v1_appsync_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
-			NumExtensions: 2,
+			NumMessages:   9,
+			NumExtensions: 5,
 			NumServices:   0,
 		},
 		GoTypes:           file_appsync_v1_appsync_proto_goTypes,
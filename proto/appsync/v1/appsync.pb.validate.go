@@ -57,6 +57,68 @@ func (m *MethodOptions) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for DeprecationReason
+
+	if all {
+		switch v := interface{}(m.GetAuth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MethodOptionsValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MethodOptionsValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MethodOptionsValidationError{
+				field:  "Auth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConnection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MethodOptionsValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MethodOptionsValidationError{
+					field:  "Connection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MethodOptionsValidationError{
+				field:  "Connection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResultField
+
 	if len(errors) > 0 {
 		return MethodOptionsMultiError(errors)
 	}
@@ -135,6 +197,115 @@ var _ interface {
 	ErrorName() string
 } = MethodOptionsValidationError{}
 
+// Validate checks the field values on Connection with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Connection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Connection with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConnectionMultiError, or
+// nil if none found.
+func (m *Connection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Connection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Items
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TypePrefix
+
+	if len(errors) > 0 {
+		return ConnectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectionMultiError is an error wrapping multiple validation errors
+// returned by Connection.ValidateAll() if the designated constraints aren't met.
+type ConnectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectionMultiError) AllErrors() []error { return m }
+
+// ConnectionValidationError is the validation error returned by
+// Connection.Validate if the designated constraints aren't met.
+type ConnectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectionValidationError) ErrorName() string { return "ConnectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectionValidationError{}
+
 // Validate checks the field values on FieldOptions with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -159,6 +330,74 @@ func (m *FieldOptions) validate(all bool) error {
 
 	// no validation rules for Ignore
 
+	// no validation rules for Json
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for DeprecationReason
+
+	if all {
+		switch v := interface{}(m.GetAuth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldOptionsValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldOptionsValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldOptionsValidationError{
+				field:  "Auth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubscribe()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldOptionsValidationError{
+					field:  "Subscribe",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldOptionsValidationError{
+					field:  "Subscribe",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscribe()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldOptionsValidationError{
+				field:  "Subscribe",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Default
+
 	if len(errors) > 0 {
 		return FieldOptionsMultiError(errors)
 	}
@@ -235,3 +474,679 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = FieldOptionsValidationError{}
+
+// Validate checks the field values on Subscribe with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subscribe) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subscribe with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubscribeMultiError, or nil
+// if none found.
+func (m *Subscribe) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subscribe) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SubscribeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeMultiError is an error wrapping multiple validation errors returned
+// by Subscribe.ValidateAll() if the designated constraints aren't met.
+type SubscribeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeMultiError) AllErrors() []error { return m }
+
+// SubscribeValidationError is the validation error returned by
+// Subscribe.Validate if the designated constraints aren't met.
+type SubscribeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeValidationError) ErrorName() string { return "SubscribeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubscribeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribe.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeValidationError{}
+
+// Validate checks the field values on EnumOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EnumOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnumOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnumOptionsMultiError, or
+// nil if none found.
+func (m *EnumOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnumOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OmitZero
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return EnumOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnumOptionsMultiError is an error wrapping multiple validation errors
+// returned by EnumOptions.ValidateAll() if the designated constraints aren't met.
+type EnumOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnumOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnumOptionsMultiError) AllErrors() []error { return m }
+
+// EnumOptionsValidationError is the validation error returned by
+// EnumOptions.Validate if the designated constraints aren't met.
+type EnumOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnumOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnumOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnumOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnumOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnumOptionsValidationError) ErrorName() string { return "EnumOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnumOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnumOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnumOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnumOptionsValidationError{}
+
+// Validate checks the field values on MessageOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageOptionsMultiError,
+// or nil if none found.
+func (m *MessageOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetAuth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageOptionsValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageOptionsValidationError{
+					field:  "Auth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageOptionsValidationError{
+				field:  "Auth",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageOptionsValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageOptionsValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageOptionsValidationError{
+				field:  "Node",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Interface
+
+	if len(errors) > 0 {
+		return MessageOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageOptionsMultiError is an error wrapping multiple validation errors
+// returned by MessageOptions.ValidateAll() if the designated constraints
+// aren't met.
+type MessageOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageOptionsMultiError) AllErrors() []error { return m }
+
+// MessageOptionsValidationError is the validation error returned by
+// MessageOptions.Validate if the designated constraints aren't met.
+type MessageOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageOptionsValidationError) ErrorName() string { return "MessageOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageOptionsValidationError{}
+
+// Validate checks the field values on Node with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Node) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Node with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NodeMultiError, or nil if none found.
+func (m *Node) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Node) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Fetch
+
+	if len(errors) > 0 {
+		return NodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeMultiError is an error wrapping multiple validation errors returned by
+// Node.ValidateAll() if the designated constraints aren't met.
+type NodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NodeMultiError) AllErrors() []error { return m }
+
+// NodeValidationError is the validation error returned by Node.Validate if the
+// designated constraints aren't met.
+type NodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeValidationError) ErrorName() string { return "NodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeValidationError{}
+
+// Validate checks the field values on EnumValueOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnumValueOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnumValueOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnumValueOptionsMultiError, or nil if none found.
+func (m *EnumValueOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnumValueOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeprecationReason
+
+	if len(errors) > 0 {
+		return EnumValueOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnumValueOptionsMultiError is an error wrapping multiple validation errors
+// returned by EnumValueOptions.ValidateAll() if the designated constraints
+// aren't met.
+type EnumValueOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnumValueOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnumValueOptionsMultiError) AllErrors() []error { return m }
+
+// EnumValueOptionsValidationError is the validation error returned by
+// EnumValueOptions.Validate if the designated constraints aren't met.
+type EnumValueOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnumValueOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnumValueOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnumValueOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnumValueOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnumValueOptionsValidationError) ErrorName() string { return "EnumValueOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnumValueOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnumValueOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnumValueOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnumValueOptionsValidationError{}
+
+// Validate checks the field values on Auth with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Auth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Auth with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuthMultiError, or nil if none found.
+func (m *Auth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Auth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApiKey
+
+	// no validation rules for Iam
+
+	// no validation rules for CognitoUserPools
+
+	// no validation rules for Oidc
+
+	// no validation rules for Lambda
+
+	if len(errors) > 0 {
+		return AuthMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthMultiError is an error wrapping multiple validation errors returned by
+// Auth.ValidateAll() if the designated constraints aren't met.
+type AuthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthMultiError) AllErrors() []error { return m }
+
+// AuthValidationError is the validation error returned by Auth.Validate if the
+// designated constraints aren't met.
+type AuthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthValidationError) ErrorName() string { return "AuthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthValidationError{}
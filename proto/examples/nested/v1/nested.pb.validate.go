@@ -92,6 +92,35 @@ func (m *Post) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PostMultiError(errors)
 	}
@@ -219,6 +248,35 @@ func (m *Query) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetListPosts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryValidationError{
+					field:  "ListPosts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryValidationError{
+					field:  "ListPosts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetListPosts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryValidationError{
+				field:  "ListPosts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return QueryMultiError(errors)
 	}
@@ -296,22 +354,22 @@ var _ interface {
 	ErrorName() string
 } = QueryValidationError{}
 
-// Validate checks the field values on RelatedPostsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelatedPostsRequest) Validate() error {
+// Validate checks the field values on Mutation with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Mutation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelatedPostsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RelatedPostsRequestMultiError, or nil if none found.
-func (m *RelatedPostsRequest) ValidateAll() error {
+// ValidateAll checks the field values on Mutation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MutationMultiError, or nil
+// if none found.
+func (m *Mutation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelatedPostsRequest) validate(all bool) error {
+func (m *Mutation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -319,28 +377,28 @@ func (m *RelatedPostsRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetParent()).(type) {
+		switch v := interface{}(m.GetCreatePost()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RelatedPostsRequestValidationError{
-					field:  "Parent",
+				errors = append(errors, MutationValidationError{
+					field:  "CreatePost",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RelatedPostsRequestValidationError{
-					field:  "Parent",
+				errors = append(errors, MutationValidationError{
+					field:  "CreatePost",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetParent()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatePost()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RelatedPostsRequestValidationError{
-				field:  "Parent",
+			return MutationValidationError{
+				field:  "CreatePost",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -348,19 +406,18 @@ func (m *RelatedPostsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RelatedPostsRequestMultiError(errors)
+		return MutationMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelatedPostsRequestMultiError is an error wrapping multiple validation
-// errors returned by RelatedPostsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RelatedPostsRequestMultiError []error
+// MutationMultiError is an error wrapping multiple validation errors returned
+// by Mutation.ValidateAll() if the designated constraints aren't met.
+type MutationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelatedPostsRequestMultiError) Error() string {
+func (m MutationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -369,11 +426,11 @@ func (m RelatedPostsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelatedPostsRequestMultiError) AllErrors() []error { return m }
+func (m MutationMultiError) AllErrors() []error { return m }
 
-// RelatedPostsRequestValidationError is the validation error returned by
-// RelatedPostsRequest.Validate if the designated constraints aren't met.
-type RelatedPostsRequestValidationError struct {
+// MutationValidationError is the validation error returned by
+// Mutation.Validate if the designated constraints aren't met.
+type MutationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -381,24 +438,22 @@ type RelatedPostsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelatedPostsRequestValidationError) Field() string { return e.field }
+func (e MutationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelatedPostsRequestValidationError) Reason() string { return e.reason }
+func (e MutationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelatedPostsRequestValidationError) Cause() error { return e.cause }
+func (e MutationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelatedPostsRequestValidationError) Key() bool { return e.key }
+func (e MutationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelatedPostsRequestValidationError) ErrorName() string {
-	return "RelatedPostsRequestValidationError"
-}
+func (e MutationValidationError) ErrorName() string { return "MutationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RelatedPostsRequestValidationError) Error() string {
+func (e MutationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -410,14 +465,14 @@ func (e RelatedPostsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelatedPostsRequest.%s: %s%s",
+		"invalid %sMutation.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelatedPostsRequestValidationError{}
+var _ error = MutationValidationError{}
 
 var _ interface {
 	Field() string
@@ -425,78 +480,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelatedPostsRequestValidationError{}
+} = MutationValidationError{}
 
-// Validate checks the field values on RelatedPostsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RelatedPostsResponse) Validate() error {
+// Validate checks the field values on Subscription with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subscription) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RelatedPostsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RelatedPostsResponseMultiError, or nil if none found.
-func (m *RelatedPostsResponse) ValidateAll() error {
+// ValidateAll checks the field values on Subscription with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubscriptionMultiError, or
+// nil if none found.
+func (m *Subscription) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RelatedPostsResponse) validate(all bool) error {
+func (m *Subscription) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPosts() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RelatedPostsResponseValidationError{
-						field:  fmt.Sprintf("Posts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RelatedPostsResponseValidationError{
-						field:  fmt.Sprintf("Posts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetPostCreated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubscriptionValidationError{
+					field:  "PostCreated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return RelatedPostsResponseValidationError{
-					field:  fmt.Sprintf("Posts[%v]", idx),
+				errors = append(errors, SubscriptionValidationError{
+					field:  "PostCreated",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPostCreated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubscriptionValidationError{
+				field:  "PostCreated",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return RelatedPostsResponseMultiError(errors)
+		return SubscriptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// RelatedPostsResponseMultiError is an error wrapping multiple validation
-// errors returned by RelatedPostsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type RelatedPostsResponseMultiError []error
+// SubscriptionMultiError is an error wrapping multiple validation errors
+// returned by Subscription.ValidateAll() if the designated constraints aren't met.
+type SubscriptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RelatedPostsResponseMultiError) Error() string {
+func (m SubscriptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -505,11 +554,11 @@ func (m RelatedPostsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RelatedPostsResponseMultiError) AllErrors() []error { return m }
+func (m SubscriptionMultiError) AllErrors() []error { return m }
 
-// RelatedPostsResponseValidationError is the validation error returned by
-// RelatedPostsResponse.Validate if the designated constraints aren't met.
-type RelatedPostsResponseValidationError struct {
+// SubscriptionValidationError is the validation error returned by
+// Subscription.Validate if the designated constraints aren't met.
+type SubscriptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -517,24 +566,22 @@ type RelatedPostsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RelatedPostsResponseValidationError) Field() string { return e.field }
+func (e SubscriptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RelatedPostsResponseValidationError) Reason() string { return e.reason }
+func (e SubscriptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RelatedPostsResponseValidationError) Cause() error { return e.cause }
+func (e SubscriptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RelatedPostsResponseValidationError) Key() bool { return e.key }
+func (e SubscriptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RelatedPostsResponseValidationError) ErrorName() string {
-	return "RelatedPostsResponseValidationError"
-}
+func (e SubscriptionValidationError) ErrorName() string { return "SubscriptionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RelatedPostsResponseValidationError) Error() string {
+func (e SubscriptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -546,14 +593,14 @@ func (e RelatedPostsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRelatedPostsResponse.%s: %s%s",
+		"invalid %sSubscription.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RelatedPostsResponseValidationError{}
+var _ error = SubscriptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -561,43 +608,159 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RelatedPostsResponseValidationError{}
+} = SubscriptionValidationError{}
 
-// Validate checks the field values on PostsRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PostsRequest) Validate() error {
+// Validate checks the field values on CreatePostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreatePostRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PostsRequestMultiError, or
-// nil if none found.
-func (m *PostsRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreatePostRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePostRequestMultiError, or nil if none found.
+func (m *CreatePostRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostsRequest) validate(all bool) error {
+func (m *CreatePostRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 64 {
+		err := CreatePostRequestValidationError{
+			field:  "Id",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreatePostRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := CreatePostRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 5 {
+		err := CreatePostRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CreatePostRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.NotifyEmail != nil {
+
+		if err := m._validateEmail(m.GetNotifyEmail()); err != nil {
+			err = CreatePostRequestValidationError{
+				field:  "NotifyEmail",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return PostsRequestMultiError(errors)
+		return CreatePostRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostsRequestMultiError is an error wrapping multiple validation errors
-// returned by PostsRequest.ValidateAll() if the designated constraints aren't met.
-type PostsRequestMultiError []error
+func (m *CreatePostRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CreatePostRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CreatePostRequestMultiError is an error wrapping multiple validation errors
+// returned by CreatePostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePostRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostsRequestMultiError) Error() string {
+func (m CreatePostRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -606,11 +769,11 @@ func (m PostsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostsRequestMultiError) AllErrors() []error { return m }
+func (m CreatePostRequestMultiError) AllErrors() []error { return m }
 
-// PostsRequestValidationError is the validation error returned by
-// PostsRequest.Validate if the designated constraints aren't met.
-type PostsRequestValidationError struct {
+// CreatePostRequestValidationError is the validation error returned by
+// CreatePostRequest.Validate if the designated constraints aren't met.
+type CreatePostRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -618,22 +781,24 @@ type PostsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostsRequestValidationError) Field() string { return e.field }
+func (e CreatePostRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostsRequestValidationError) Reason() string { return e.reason }
+func (e CreatePostRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostsRequestValidationError) Cause() error { return e.cause }
+func (e CreatePostRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostsRequestValidationError) Key() bool { return e.key }
+func (e CreatePostRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostsRequestValidationError) ErrorName() string { return "PostsRequestValidationError" }
+func (e CreatePostRequestValidationError) ErrorName() string {
+	return "CreatePostRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PostsRequestValidationError) Error() string {
+func (e CreatePostRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -645,14 +810,14 @@ func (e PostsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostsRequest.%s: %s%s",
+		"invalid %sCreatePostRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostsRequestValidationError{}
+var _ error = CreatePostRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -660,78 +825,1052 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostsRequestValidationError{}
+} = CreatePostRequestValidationError{}
 
-// Validate checks the field values on PostsResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PostsResponse) Validate() error {
+var _CreatePostRequest_Id_Pattern = regexp.MustCompile("^[a-z0-9-]+$")
+
+// Validate checks the field values on CreatePostResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePostResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PostsResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PostsResponseMultiError, or
+// ValidateAll checks the field values on CreatePostResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePostResponseMultiError, or nil if none found.
+func (m *CreatePostResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePostResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetPost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePostResponseValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePostResponseValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePostResponseValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePostResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePostResponseMultiError is an error wrapping multiple validation errors
+// returned by CreatePostResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePostResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePostResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePostResponseMultiError) AllErrors() []error { return m }
+
+// CreatePostResponseValidationError is the validation error returned by
+// CreatePostResponse.Validate if the designated constraints aren't met.
+type CreatePostResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePostResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePostResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePostResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePostResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePostResponseValidationError) ErrorName() string {
+	return "CreatePostResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePostResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePostResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePostResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePostResponseValidationError{}
+
+// Validate checks the field values on RelatedPostsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelatedPostsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelatedPostsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelatedPostsRequestMultiError, or nil if none found.
+func (m *RelatedPostsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelatedPostsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetParent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RelatedPostsRequestValidationError{
+					field:  "Parent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RelatedPostsRequestValidationError{
+					field:  "Parent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetParent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RelatedPostsRequestValidationError{
+				field:  "Parent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RelatedPostsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelatedPostsRequestMultiError is an error wrapping multiple validation
+// errors returned by RelatedPostsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RelatedPostsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelatedPostsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelatedPostsRequestMultiError) AllErrors() []error { return m }
+
+// RelatedPostsRequestValidationError is the validation error returned by
+// RelatedPostsRequest.Validate if the designated constraints aren't met.
+type RelatedPostsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelatedPostsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelatedPostsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelatedPostsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelatedPostsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelatedPostsRequestValidationError) ErrorName() string {
+	return "RelatedPostsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelatedPostsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelatedPostsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelatedPostsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelatedPostsRequestValidationError{}
+
+// Validate checks the field values on RelatedPostsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RelatedPostsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelatedPostsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RelatedPostsResponseMultiError, or nil if none found.
+func (m *RelatedPostsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelatedPostsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RelatedPostsResponseValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RelatedPostsResponseValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RelatedPostsResponseValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RelatedPostsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelatedPostsResponseMultiError is an error wrapping multiple validation
+// errors returned by RelatedPostsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RelatedPostsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelatedPostsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelatedPostsResponseMultiError) AllErrors() []error { return m }
+
+// RelatedPostsResponseValidationError is the validation error returned by
+// RelatedPostsResponse.Validate if the designated constraints aren't met.
+type RelatedPostsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelatedPostsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelatedPostsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelatedPostsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelatedPostsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelatedPostsResponseValidationError) ErrorName() string {
+	return "RelatedPostsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelatedPostsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelatedPostsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelatedPostsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelatedPostsResponseValidationError{}
+
+// Validate checks the field values on PostsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PostsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PostsRequestMultiError, or
+// nil if none found.
+func (m *PostsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PostsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostsRequestMultiError is an error wrapping multiple validation errors
+// returned by PostsRequest.ValidateAll() if the designated constraints aren't met.
+type PostsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostsRequestMultiError) AllErrors() []error { return m }
+
+// PostsRequestValidationError is the validation error returned by
+// PostsRequest.Validate if the designated constraints aren't met.
+type PostsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostsRequestValidationError) ErrorName() string { return "PostsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostsRequestValidationError{}
+
+// Validate checks the field values on PostsResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PostsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PostsResponseMultiError, or
 // nil if none found.
 func (m *PostsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PostsResponse) validate(all bool) error {
+func (m *PostsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PostsResponseValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PostsResponseValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PostsResponseValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PostsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostsResponseMultiError is an error wrapping multiple validation errors
+// returned by PostsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PostsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostsResponseMultiError) AllErrors() []error { return m }
+
+// PostsResponseValidationError is the validation error returned by
+// PostsResponse.Validate if the designated constraints aren't met.
+type PostsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostsResponseValidationError) ErrorName() string { return "PostsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PostsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostsResponseValidationError{}
+
+// Validate checks the field values on ListPostsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPostsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPostsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPostsRequestMultiError, or nil if none found.
+func (m *ListPostsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPostsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListPostsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPostsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPostsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPostsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPostsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPostsRequestMultiError) AllErrors() []error { return m }
+
+// ListPostsRequestValidationError is the validation error returned by
+// ListPostsRequest.Validate if the designated constraints aren't met.
+type ListPostsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPostsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPostsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPostsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPostsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPostsRequestValidationError) ErrorName() string { return "ListPostsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPostsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPostsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPostsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPostsRequestValidationError{}
+
+// Validate checks the field values on ListPostsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPostsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPostsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPostsResponseMultiError, or nil if none found.
+func (m *ListPostsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPostsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPostsResponseValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPostsResponseValidationError{
+						field:  fmt.Sprintf("Posts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPostsResponseValidationError{
+					field:  fmt.Sprintf("Posts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListPostsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPostsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPostsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPostsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPostsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPostsResponseMultiError) AllErrors() []error { return m }
+
+// ListPostsResponseValidationError is the validation error returned by
+// ListPostsResponse.Validate if the designated constraints aren't met.
+type ListPostsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPostsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPostsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPostsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPostsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPostsResponseValidationError) ErrorName() string {
+	return "ListPostsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPostsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPostsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPostsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPostsResponseValidationError{}
+
+// Validate checks the field values on GetPostRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetPostRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPostRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetPostRequestMultiError,
+// or nil if none found.
+func (m *GetPostRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPostRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPosts() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PostsResponseValidationError{
-						field:  fmt.Sprintf("Posts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PostsResponseValidationError{
-						field:  fmt.Sprintf("Posts[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if len(errors) > 0 {
+		return GetPostRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPostRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPostRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPostRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPostRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPostRequestMultiError) AllErrors() []error { return m }
+
+// GetPostRequestValidationError is the validation error returned by
+// GetPostRequest.Validate if the designated constraints aren't met.
+type GetPostRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPostRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPostRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPostRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPostRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPostRequestValidationError) ErrorName() string { return "GetPostRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPostRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPostRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPostRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPostRequestValidationError{}
+
+// Validate checks the field values on GetPostResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPostResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPostResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPostResponseMultiError, or nil if none found.
+func (m *GetPostResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPostResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPostResponseValidationError{
+					field:  "Post",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PostsResponseValidationError{
-					field:  fmt.Sprintf("Posts[%v]", idx),
+				errors = append(errors, GetPostResponseValidationError{
+					field:  "Post",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPostResponseValidationError{
+				field:  "Post",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return PostsResponseMultiError(errors)
+		return GetPostResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PostsResponseMultiError is an error wrapping multiple validation errors
-// returned by PostsResponse.ValidateAll() if the designated constraints
+// GetPostResponseMultiError is an error wrapping multiple validation errors
+// returned by GetPostResponse.ValidateAll() if the designated constraints
 // aren't met.
-type PostsResponseMultiError []error
+type GetPostResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PostsResponseMultiError) Error() string {
+func (m GetPostResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -740,11 +1879,11 @@ func (m PostsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PostsResponseMultiError) AllErrors() []error { return m }
+func (m GetPostResponseMultiError) AllErrors() []error { return m }
 
-// PostsResponseValidationError is the validation error returned by
-// PostsResponse.Validate if the designated constraints aren't met.
-type PostsResponseValidationError struct {
+// GetPostResponseValidationError is the validation error returned by
+// GetPostResponse.Validate if the designated constraints aren't met.
+type GetPostResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -752,22 +1891,22 @@ type PostsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PostsResponseValidationError) Field() string { return e.field }
+func (e GetPostResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PostsResponseValidationError) Reason() string { return e.reason }
+func (e GetPostResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PostsResponseValidationError) Cause() error { return e.cause }
+func (e GetPostResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PostsResponseValidationError) Key() bool { return e.key }
+func (e GetPostResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PostsResponseValidationError) ErrorName() string { return "PostsResponseValidationError" }
+func (e GetPostResponseValidationError) ErrorName() string { return "GetPostResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PostsResponseValidationError) Error() string {
+func (e GetPostResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -779,14 +1918,14 @@ func (e PostsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPostsResponse.%s: %s%s",
+		"invalid %sGetPostResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PostsResponseValidationError{}
+var _ error = GetPostResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -794,4 +1933,105 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PostsResponseValidationError{}
+} = GetPostResponseValidationError{}
+
+// Validate checks the field values on Post_Author with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Post_Author) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Post_Author with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Post_AuthorMultiError, or
+// nil if none found.
+func (m *Post_Author) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Post_Author) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return Post_AuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// Post_AuthorMultiError is an error wrapping multiple validation errors
+// returned by Post_Author.ValidateAll() if the designated constraints aren't met.
+type Post_AuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Post_AuthorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Post_AuthorMultiError) AllErrors() []error { return m }
+
+// Post_AuthorValidationError is the validation error returned by
+// Post_Author.Validate if the designated constraints aren't met.
+type Post_AuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Post_AuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Post_AuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Post_AuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Post_AuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Post_AuthorValidationError) ErrorName() string { return "Post_AuthorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Post_AuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPost_Author.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Post_AuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Post_AuthorValidationError{}
@@ -1,10 +1,10 @@
 package nestedv1
 
 import (
-	"fmt"
-	"context"
-	"google.golang.org/protobuf/encoding/protojson"
-	connectgo "github.com/bufbuild/connect-go"
+	context "context"
+	fmt "fmt"
+	connect_go "github.com/bufbuild/connect-go"
+	appsyncjson "github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
 )
 
 //
@@ -12,14 +12,21 @@ import (
 // ResolveSelectors list all type and field names that are resolved by the protobuf rpc methods. This
 // is usefull to automate hooking up lambda functions to them in AppSync using a tool like AWS CDK.
 var ResolveSelectors = []string{
-	"Post.related", "Query.posts",
+	"Query.posts", "Post.related", "Query.listPosts", "Mutation.createPost",
+	"Query.node",
 }
 
 // PostServiceResolver describes the resolver implementation using connect signatures.
 type PostServiceResolver interface {
-	Posts(context.Context, *connectgo.Request[PostsRequest]) (*connectgo.Response[PostsResponse], error)
+	Posts(context.Context, *connect_go.Request[PostsRequest]) (*connect_go.Response[PostsResponse], error)
 
-	RelatedPosts(context.Context, *connectgo.Request[RelatedPostsRequest]) (*connectgo.Response[RelatedPostsResponse], error)
+	RelatedPosts(context.Context, *connect_go.Request[RelatedPostsRequest]) (*connect_go.Response[RelatedPostsResponse], error)
+
+	ListPosts(context.Context, *connect_go.Request[ListPostsRequest]) (*connect_go.Response[ListPostsResponse], error)
+
+	GetPost(context.Context, *connect_go.Request[GetPostRequest]) (*connect_go.Response[GetPostResponse], error)
+
+	CreatePost(context.Context, *connect_go.Request[CreatePostRequest]) (*connect_go.Response[CreatePostResponse], error)
 }
 
 // ResolvePostService resolves graphql calls
@@ -27,43 +34,114 @@ func ResolvePostService(ctx context.Context, h PostServiceResolver, typName, fld
 	qualifier := fmt.Sprintf("%s.%s", typName, fldName)
 	switch qualifier {
 
+	case "Query.posts":
+		var in PostsRequest
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
+		}
+
+		req := connect_go.NewRequest(&in)
+
+		resp, err := h.Posts(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call handler: %w", err)
+		}
+
+		if data, err = appsyncjson.Marshal(resp.Msg); err != nil {
+			return nil, fmt.Errorf("failed to marshal output: %w", err)
+		}
+
+		return data, nil
+
 	case "Post.related":
 		var in RelatedPostsRequest
-		if err := protojson.Unmarshal(args, &in); err != nil {
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
 		}
 
-		req := connectgo.NewRequest(&in)
+		req := connect_go.NewRequest(&in)
 
 		resp, err := h.RelatedPosts(ctx, req)
 		if err != nil {
 			return nil, fmt.Errorf("failed to call handler: %w", err)
 		}
 
-		if data, err = protojson.Marshal(resp.Msg); err != nil {
+		if data, err = (appsyncjson.MarshalOptions{}).MarshalField(resp.Msg, "posts"); err != nil {
 			return nil, fmt.Errorf("failed to marshal output: %w", err)
 		}
 
 		return data, nil
 
-	case "Query.posts":
-		var in PostsRequest
-		if err := protojson.Unmarshal(args, &in); err != nil {
+	case "Query.listPosts":
+		var in ListPostsRequest
+		conn := appsyncjson.Connection{
+			PageSize: "page_size", PageToken: "page_token",
+			Items: "posts", NextPageToken: "next_page_token",
+		}
+
+		page, err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).UnmarshalConnection(args, &in, conn)
+		if err != nil {
 			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
 		}
 
-		req := connectgo.NewRequest(&in)
+		req := connect_go.NewRequest(&in)
 
-		resp, err := h.Posts(ctx, req)
+		resp, err := h.ListPosts(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call handler: %w", err)
+		}
+
+		if data, err = (appsyncjson.MarshalOptions{}).MarshalConnection(resp.Msg, conn, page); err != nil {
+			return nil, fmt.Errorf("failed to marshal output: %w", err)
+		}
+
+		return data, nil
+
+	case "Mutation.createPost":
+		var in CreatePostRequest
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
+		}
+
+		req := connect_go.NewRequest(&in)
+
+		resp, err := h.CreatePost(ctx, req)
 		if err != nil {
 			return nil, fmt.Errorf("failed to call handler: %w", err)
 		}
 
-		if data, err = protojson.Marshal(resp.Msg); err != nil {
+		if data, err = appsyncjson.Marshal(resp.Msg); err != nil {
 			return nil, fmt.Errorf("failed to marshal output: %w", err)
 		}
 
 		return data, nil
+
+	case "Query.node":
+		msgName, id, err := appsyncjson.UnmarshalNodeID(args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
+		}
+
+		switch msgName {
+		case "examples.nested.v1.Post":
+			var in GetPostRequest
+			in.Id = id
+
+			resp, err := h.GetPost(ctx, connect_go.NewRequest(&in))
+			if connect_go.CodeOf(err) == connect_go.CodeNotFound {
+				return []byte("null"), nil
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to call handler: %w", err)
+			}
+
+			if data, err = (appsyncjson.MarshalOptions{}).MarshalAs(resp.Msg.GetPost(), "Post"); err != nil {
+				return nil, fmt.Errorf("failed to marshal output: %w", err)
+			}
+
+			return data, nil
+		default:
+			return []byte("null"), nil // ids of unknown types identify no node
+		}
 	default:
 		return nil, fmt.Errorf("unsupported: %s", qualifier)
 	}
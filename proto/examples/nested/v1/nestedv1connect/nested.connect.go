@@ -31,6 +31,12 @@ type PostServiceClient interface {
 	Posts(context.Context, *connect_go.Request[v1.PostsRequest]) (*connect_go.Response[v1.PostsResponse], error)
 	// related posts from a single post
 	RelatedPosts(context.Context, *connect_go.Request[v1.RelatedPostsRequest]) (*connect_go.Response[v1.RelatedPostsResponse], error)
+	// paginated listing of posts, exposed as a relay connection
+	ListPosts(context.Context, *connect_go.Request[v1.ListPostsRequest]) (*connect_go.Response[v1.ListPostsResponse], error)
+	// fetch a single post by its id, for the relay node field
+	GetPost(context.Context, *connect_go.Request[v1.GetPostRequest]) (*connect_go.Response[v1.GetPostResponse], error)
+	// create a post
+	CreatePost(context.Context, *connect_go.Request[v1.CreatePostRequest]) (*connect_go.Response[v1.CreatePostResponse], error)
 }
 
 // NewPostServiceClient constructs a client for the examples.nested.v1.PostService service. By
@@ -53,6 +59,21 @@ func NewPostServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts
 			baseURL+"/examples.nested.v1.PostService/RelatedPosts",
 			opts...,
 		),
+		listPosts: connect_go.NewClient[v1.ListPostsRequest, v1.ListPostsResponse](
+			httpClient,
+			baseURL+"/examples.nested.v1.PostService/ListPosts",
+			opts...,
+		),
+		getPost: connect_go.NewClient[v1.GetPostRequest, v1.GetPostResponse](
+			httpClient,
+			baseURL+"/examples.nested.v1.PostService/GetPost",
+			opts...,
+		),
+		createPost: connect_go.NewClient[v1.CreatePostRequest, v1.CreatePostResponse](
+			httpClient,
+			baseURL+"/examples.nested.v1.PostService/CreatePost",
+			opts...,
+		),
 	}
 }
 
@@ -60,6 +81,9 @@ func NewPostServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts
 type postServiceClient struct {
 	posts        *connect_go.Client[v1.PostsRequest, v1.PostsResponse]
 	relatedPosts *connect_go.Client[v1.RelatedPostsRequest, v1.RelatedPostsResponse]
+	listPosts    *connect_go.Client[v1.ListPostsRequest, v1.ListPostsResponse]
+	getPost      *connect_go.Client[v1.GetPostRequest, v1.GetPostResponse]
+	createPost   *connect_go.Client[v1.CreatePostRequest, v1.CreatePostResponse]
 }
 
 // Posts calls examples.nested.v1.PostService.Posts.
@@ -72,12 +96,33 @@ func (c *postServiceClient) RelatedPosts(ctx context.Context, req *connect_go.Re
 	return c.relatedPosts.CallUnary(ctx, req)
 }
 
+// ListPosts calls examples.nested.v1.PostService.ListPosts.
+func (c *postServiceClient) ListPosts(ctx context.Context, req *connect_go.Request[v1.ListPostsRequest]) (*connect_go.Response[v1.ListPostsResponse], error) {
+	return c.listPosts.CallUnary(ctx, req)
+}
+
+// GetPost calls examples.nested.v1.PostService.GetPost.
+func (c *postServiceClient) GetPost(ctx context.Context, req *connect_go.Request[v1.GetPostRequest]) (*connect_go.Response[v1.GetPostResponse], error) {
+	return c.getPost.CallUnary(ctx, req)
+}
+
+// CreatePost calls examples.nested.v1.PostService.CreatePost.
+func (c *postServiceClient) CreatePost(ctx context.Context, req *connect_go.Request[v1.CreatePostRequest]) (*connect_go.Response[v1.CreatePostResponse], error) {
+	return c.createPost.CallUnary(ctx, req)
+}
+
 // PostServiceHandler is an implementation of the examples.nested.v1.PostService service.
 type PostServiceHandler interface {
 	// Post listing method
 	Posts(context.Context, *connect_go.Request[v1.PostsRequest]) (*connect_go.Response[v1.PostsResponse], error)
 	// related posts from a single post
 	RelatedPosts(context.Context, *connect_go.Request[v1.RelatedPostsRequest]) (*connect_go.Response[v1.RelatedPostsResponse], error)
+	// paginated listing of posts, exposed as a relay connection
+	ListPosts(context.Context, *connect_go.Request[v1.ListPostsRequest]) (*connect_go.Response[v1.ListPostsResponse], error)
+	// fetch a single post by its id, for the relay node field
+	GetPost(context.Context, *connect_go.Request[v1.GetPostRequest]) (*connect_go.Response[v1.GetPostResponse], error)
+	// create a post
+	CreatePost(context.Context, *connect_go.Request[v1.CreatePostRequest]) (*connect_go.Response[v1.CreatePostResponse], error)
 }
 
 // NewPostServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -97,6 +142,21 @@ func NewPostServiceHandler(svc PostServiceHandler, opts ...connect_go.HandlerOpt
 		svc.RelatedPosts,
 		opts...,
 	))
+	mux.Handle("/examples.nested.v1.PostService/ListPosts", connect_go.NewUnaryHandler(
+		"/examples.nested.v1.PostService/ListPosts",
+		svc.ListPosts,
+		opts...,
+	))
+	mux.Handle("/examples.nested.v1.PostService/GetPost", connect_go.NewUnaryHandler(
+		"/examples.nested.v1.PostService/GetPost",
+		svc.GetPost,
+		opts...,
+	))
+	mux.Handle("/examples.nested.v1.PostService/CreatePost", connect_go.NewUnaryHandler(
+		"/examples.nested.v1.PostService/CreatePost",
+		svc.CreatePost,
+		opts...,
+	))
 	return "/examples.nested.v1.PostService/", mux
 }
 
@@ -110,3 +170,15 @@ func (UnimplementedPostServiceHandler) Posts(context.Context, *connect_go.Reques
 func (UnimplementedPostServiceHandler) RelatedPosts(context.Context, *connect_go.Request[v1.RelatedPostsRequest]) (*connect_go.Response[v1.RelatedPostsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("examples.nested.v1.PostService.RelatedPosts is not implemented"))
 }
+
+func (UnimplementedPostServiceHandler) ListPosts(context.Context, *connect_go.Request[v1.ListPostsRequest]) (*connect_go.Response[v1.ListPostsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("examples.nested.v1.PostService.ListPosts is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) GetPost(context.Context, *connect_go.Request[v1.GetPostRequest]) (*connect_go.Response[v1.GetPostResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("examples.nested.v1.PostService.GetPost is not implemented"))
+}
+
+func (UnimplementedPostServiceHandler) CreatePost(context.Context, *connect_go.Request[v1.CreatePostRequest]) (*connect_go.Response[v1.CreatePostResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("examples.nested.v1.PostService.CreatePost is not implemented"))
+}
@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: examples/pagination/v1/pagination.proto
+
+package paginationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PageRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PageRequestMultiError, or
+// nil if none found.
+func (m *PageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cursor
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return PageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PageRequestMultiError is an error wrapping multiple validation errors
+// returned by PageRequest.ValidateAll() if the designated constraints aren't met.
+type PageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageRequestMultiError) AllErrors() []error { return m }
+
+// PageRequestValidationError is the validation error returned by
+// PageRequest.Validate if the designated constraints aren't met.
+type PageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PageRequestValidationError) ErrorName() string { return "PageRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PageRequestValidationError{}
+
+// Validate checks the field values on PageInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PageInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PageInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PageInfoMultiError, or nil
+// if none found.
+func (m *PageInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PageInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return PageInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PageInfoMultiError is an error wrapping multiple validation errors returned
+// by PageInfo.ValidateAll() if the designated constraints aren't met.
+type PageInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PageInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PageInfoMultiError) AllErrors() []error { return m }
+
+// PageInfoValidationError is the validation error returned by
+// PageInfo.Validate if the designated constraints aren't met.
+type PageInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PageInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PageInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PageInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PageInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PageInfoValidationError) ErrorName() string { return "PageInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PageInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPageInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PageInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PageInfoValidationError{}
@@ -8,8 +8,16 @@ package simplev1
 
 import (
 	_ "github.com/crewlinker/protoc-gen-appsync-go/proto/appsync/v1"
+	v1 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/pagination/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +29,171 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Mood is a top-level enum with an alias
+type Mood int32
+
+const (
+	// mood is not specified
+	Mood_MOOD_UNSPECIFIED Mood = 0
+	// happy mood
+	Mood_MOOD_HAPPY Mood = 1
+	// alias for the happy mood
+	//
+	// Deprecated: Do not use.
+	Mood_MOOD_JOYFUL Mood = 1
+	// sad mood
+	Mood_MOOD_SAD Mood = 2
+)
+
+// Enum value maps for Mood.
+var (
+	Mood_name = map[int32]string{
+		0: "MOOD_UNSPECIFIED",
+		1: "MOOD_HAPPY",
+		// Duplicate value: 1: "MOOD_JOYFUL",
+		2: "MOOD_SAD",
+	}
+	Mood_value = map[string]int32{
+		"MOOD_UNSPECIFIED": 0,
+		"MOOD_HAPPY":       1,
+		"MOOD_JOYFUL":      1,
+		"MOOD_SAD":         2,
+	}
+)
+
+func (x Mood) Enum() *Mood {
+	p := new(Mood)
+	*p = x
+	return p
+}
+
+func (x Mood) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Mood) Descriptor() protoreflect.EnumDescriptor {
+	return file_examples_simple_v1_simple_proto_enumTypes[0].Descriptor()
+}
+
+func (Mood) Type() protoreflect.EnumType {
+	return &file_examples_simple_v1_simple_proto_enumTypes[0]
+}
+
+func (x Mood) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Mood.Descriptor instead.
+func (Mood) EnumDescriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{0}
+}
+
+// Weather is an enum without the zero value in the graphql schema
+type Weather int32
+
+const (
+	// weather is not specified
+	Weather_WEATHER_UNSPECIFIED Weather = 0
+	// sunny weather
+	Weather_WEATHER_SUNNY Weather = 1
+	// rainy weather
+	Weather_WEATHER_RAINY Weather = 2
+)
+
+// Enum value maps for Weather.
+var (
+	Weather_name = map[int32]string{
+		0: "WEATHER_UNSPECIFIED",
+		1: "WEATHER_SUNNY",
+		2: "WEATHER_RAINY",
+	}
+	Weather_value = map[string]int32{
+		"WEATHER_UNSPECIFIED": 0,
+		"WEATHER_SUNNY":       1,
+		"WEATHER_RAINY":       2,
+	}
+)
+
+func (x Weather) Enum() *Weather {
+	p := new(Weather)
+	*p = x
+	return p
+}
+
+func (x Weather) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weather) Descriptor() protoreflect.EnumDescriptor {
+	return file_examples_simple_v1_simple_proto_enumTypes[1].Descriptor()
+}
+
+func (Weather) Type() protoreflect.EnumType {
+	return &file_examples_simple_v1_simple_proto_enumTypes[1]
+}
+
+func (x Weather) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weather.Descriptor instead.
+func (Weather) EnumDescriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{1}
+}
+
+// Level is an enum nested in a message
+type EnumKinds_Level int32
+
+const (
+	// level is not specified
+	EnumKinds_LEVEL_UNSPECIFIED EnumKinds_Level = 0
+	// low level
+	EnumKinds_LEVEL_LOW EnumKinds_Level = 1
+	// high level
+	EnumKinds_LEVEL_HIGH EnumKinds_Level = 2
+)
+
+// Enum value maps for EnumKinds_Level.
+var (
+	EnumKinds_Level_name = map[int32]string{
+		0: "LEVEL_UNSPECIFIED",
+		1: "LEVEL_LOW",
+		2: "LEVEL_HIGH",
+	}
+	EnumKinds_Level_value = map[string]int32{
+		"LEVEL_UNSPECIFIED": 0,
+		"LEVEL_LOW":         1,
+		"LEVEL_HIGH":        2,
+	}
+)
+
+func (x EnumKinds_Level) Enum() *EnumKinds_Level {
+	p := new(EnumKinds_Level)
+	*p = x
+	return p
+}
+
+func (x EnumKinds_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumKinds_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_examples_simple_v1_simple_proto_enumTypes[2].Descriptor()
+}
+
+func (EnumKinds_Level) Type() protoreflect.EnumType {
+	return &file_examples_simple_v1_simple_proto_enumTypes[2]
+}
+
+func (x EnumKinds_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumKinds_Level.Descriptor instead.
+func (EnumKinds_Level) EnumDescriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{12, 0}
+}
+
 // Query describes the top-level query object
 type Query struct {
 	state         protoimpl.MessageState
@@ -32,12 +205,20 @@ type Query struct {
 	// unless it's an empty message.
 	Echo *EchoResponse `protobuf:"bytes,1,opt,name=echo,proto3" json:"echo,omitempty"`
 	// another field that has the same resolver
+	//
+	// Deprecated: Do not use.
 	EchoV2 *EchoResponse `protobuf:"bytes,3,opt,name=echo_v2,json=echoV2,proto3" json:"echo_v2,omitempty"`
 	// List profiles
 	ListProfiles *ListProfilesResponse `protobuf:"bytes,4,opt,name=list_profiles,json=listProfiles,proto3" json:"list_profiles,omitempty"`
-	// version resolves to a scalar type. which requires a extra option to configure the
-	// field in the response type that will hold the resolving value.
+	// version resolves to a scalar type, which is taken from the field of the response that is
+	// configured with the result_field option of the method.
 	LatestVersion string `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// echo all scalar kinds back
+	EchoKinds *EchoKindsResponse `protobuf:"bytes,5,opt,name=echo_kinds,json=echoKinds,proto3" json:"echo_kinds,omitempty"`
+	// resolves to a message from another package
+	NextPage *v1.PageInfo `protobuf:"bytes,6,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+	// resolves to notifications of different kinds
+	Notifications *ListNotificationsResponse `protobuf:"bytes,7,opt,name=notifications,proto3" json:"notifications,omitempty"`
 }
 
 func (x *Query) Reset() {
@@ -79,6 +260,7 @@ func (x *Query) GetEcho() *EchoResponse {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (x *Query) GetEchoV2() *EchoResponse {
 	if x != nil {
 		return x.EchoV2
@@ -100,6 +282,27 @@ func (x *Query) GetLatestVersion() string {
 	return ""
 }
 
+func (x *Query) GetEchoKinds() *EchoKindsResponse {
+	if x != nil {
+		return x.EchoKinds
+	}
+	return nil
+}
+
+func (x *Query) GetNextPage() *v1.PageInfo {
+	if x != nil {
+		return x.NextPage
+	}
+	return nil
+}
+
+func (x *Query) GetNotifications() *ListNotificationsResponse {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
 // Pagination provides a standard input for paginated results
 type Pagination struct {
 	state         protoimpl.MessageState
@@ -206,6 +409,8 @@ type ListProfilesResponse struct {
 
 	// profile ids
 	ProfileIds []string `protobuf:"bytes,1,rep,name=profile_ids,json=profileIds,proto3" json:"profile_ids,omitempty"`
+	// total number of profiles, named differently in the graphql schema
+	TotalProfiles int32 `protobuf:"varint,2,opt,name=total_profiles,json=totalProfiles,proto3" json:"total_profiles,omitempty"`
 }
 
 func (x *ListProfilesResponse) Reset() {
@@ -247,6 +452,13 @@ func (x *ListProfilesResponse) GetProfileIds() []string {
 	return nil
 }
 
+func (x *ListProfilesResponse) GetTotalProfiles() int32 {
+	if x != nil {
+		return x.TotalProfiles
+	}
+	return 0
+}
+
 // EchoRequest sends a message to be echoed
 type EchoRequest struct {
 	state         protoimpl.MessageState
@@ -255,6 +467,16 @@ type EchoRequest struct {
 
 	// message to echo
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Deprecated: use the prefix decoration
+	//
+	// Deprecated: Do not use.
+	Leader string `protobuf:"bytes,4,opt,name=leader,proto3" json:"leader,omitempty"`
+	// optional decoration of the echo
+	//
+	// Types that are assignable to Decoration:
+	//	*EchoRequest_Prefix
+	//	*EchoRequest_Repeat
+	Decoration isEchoRequest_Decoration `protobuf_oneof:"decoration"`
 }
 
 func (x *EchoRequest) Reset() {
@@ -296,6 +518,53 @@ func (x *EchoRequest) GetMessage() string {
 	return ""
 }
 
+// Deprecated: Do not use.
+func (x *EchoRequest) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+func (m *EchoRequest) GetDecoration() isEchoRequest_Decoration {
+	if m != nil {
+		return m.Decoration
+	}
+	return nil
+}
+
+func (x *EchoRequest) GetPrefix() string {
+	if x, ok := x.GetDecoration().(*EchoRequest_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *EchoRequest) GetRepeat() int32 {
+	if x, ok := x.GetDecoration().(*EchoRequest_Repeat); ok {
+		return x.Repeat
+	}
+	return 0
+}
+
+type isEchoRequest_Decoration interface {
+	isEchoRequest_Decoration()
+}
+
+type EchoRequest_Prefix struct {
+	// prefix the echo
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+}
+
+type EchoRequest_Repeat struct {
+	// repeat the echo a number of times
+	Repeat int32 `protobuf:"varint,3,opt,name=repeat,proto3,oneof"`
+}
+
+func (*EchoRequest_Prefix) isEchoRequest_Decoration() {}
+
+func (*EchoRequest_Repeat) isEchoRequest_Decoration() {}
+
 // EchoResponse sends a message to be echoed
 type EchoResponse struct {
 	state         protoimpl.MessageState
@@ -304,6 +573,12 @@ type EchoResponse struct {
 
 	// returned message
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// decoration that was applied to the echo
+	//
+	// Types that are assignable to Decoration:
+	//	*EchoResponse_Prefix
+	//	*EchoResponse_Repeat
+	Decoration isEchoResponse_Decoration `protobuf_oneof:"decoration"`
 }
 
 func (x *EchoResponse) Reset() {
@@ -345,6 +620,45 @@ func (x *EchoResponse) GetMessage() string {
 	return ""
 }
 
+func (m *EchoResponse) GetDecoration() isEchoResponse_Decoration {
+	if m != nil {
+		return m.Decoration
+	}
+	return nil
+}
+
+func (x *EchoResponse) GetPrefix() string {
+	if x, ok := x.GetDecoration().(*EchoResponse_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *EchoResponse) GetRepeat() int32 {
+	if x, ok := x.GetDecoration().(*EchoResponse_Repeat); ok {
+		return x.Repeat
+	}
+	return 0
+}
+
+type isEchoResponse_Decoration interface {
+	isEchoResponse_Decoration()
+}
+
+type EchoResponse_Prefix struct {
+	// the echo was prefixed
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof"`
+}
+
+type EchoResponse_Repeat struct {
+	// the echo was repeated a number of times
+	Repeat int32 `protobuf:"varint,3,opt,name=repeat,proto3,oneof"`
+}
+
+func (*EchoResponse_Prefix) isEchoResponse_Decoration() {}
+
+func (*EchoResponse_Repeat) isEchoResponse_Decoration() {}
+
 // VersionRequests asks for the version
 type VersionRequest struct {
 	state         protoimpl.MessageState
@@ -433,216 +747,1840 @@ func (x *VersionResponse) GetVersion() string {
 	return ""
 }
 
-var File_examples_simple_v1_simple_proto protoreflect.FileDescriptor
+// ScalarKinds holds a field for every protobuf scalar kind
+type ScalarKinds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_examples_simple_v1_simple_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x12, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xee, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x65, 0x63, 0x68,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x65, 0x63, 0x68, 0x6f, 0x12,
-	0x39, 0x0a, 0x07, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x76, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x06, 0x65, 0x63, 0x68, 0x6f, 0x56, 0x32, 0x12, 0x4d, 0x0a, 0x0d, 0x6c, 0x69,
-	0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x6c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x20, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49,
-	0x64, 0x73, 0x22, 0x27, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x45,
-	0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x32, 0xe6, 0x02, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x69, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x1f,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1e, 0xda, 0x44, 0x1b, 0x1a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x65, 0x63,
-	0x68, 0x6f, 0x1a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x76,
-	0x32, 0x12, 0x7b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x73, 0x12, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0xda, 0x44, 0x15, 0x1a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x2e, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x6d,
-	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x19, 0xda, 0x44, 0x16, 0x1a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x6c,
-	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0xde, 0x01,
-	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e,
-	0x63, 0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x73, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x53, 0x58, 0xaa, 0x02, 0x12, 0x45,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x5c, 0x53, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x73, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x73, 0x3a, 0x3a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// double kind
+	DoubleValue float64 `protobuf:"fixed64,1,opt,name=double_value,json=doubleValue,proto3" json:"double_value,omitempty"`
+	// float kind
+	FloatValue float32 `protobuf:"fixed32,2,opt,name=float_value,json=floatValue,proto3" json:"float_value,omitempty"`
+	// int32 kind
+	Int32Value int32 `protobuf:"varint,3,opt,name=int32_value,json=int32Value,proto3" json:"int32_value,omitempty"`
+	// int64 kind
+	Int64Value int64 `protobuf:"varint,4,opt,name=int64_value,json=int64Value,proto3" json:"int64_value,omitempty"`
+	// uint32 kind
+	Uint32Value uint32 `protobuf:"varint,5,opt,name=uint32_value,json=uint32Value,proto3" json:"uint32_value,omitempty"`
+	// uint64 kind
+	Uint64Value uint64 `protobuf:"varint,6,opt,name=uint64_value,json=uint64Value,proto3" json:"uint64_value,omitempty"`
+	// sint32 kind
+	Sint32Value int32 `protobuf:"zigzag32,7,opt,name=sint32_value,json=sint32Value,proto3" json:"sint32_value,omitempty"`
+	// sint64 kind
+	Sint64Value int64 `protobuf:"zigzag64,8,opt,name=sint64_value,json=sint64Value,proto3" json:"sint64_value,omitempty"`
+	// fixed32 kind
+	Fixed32Value uint32 `protobuf:"fixed32,9,opt,name=fixed32_value,json=fixed32Value,proto3" json:"fixed32_value,omitempty"`
+	// fixed64 kind
+	Fixed64Value uint64 `protobuf:"fixed64,10,opt,name=fixed64_value,json=fixed64Value,proto3" json:"fixed64_value,omitempty"`
+	// sfixed32 kind
+	Sfixed32Value int32 `protobuf:"fixed32,11,opt,name=sfixed32_value,json=sfixed32Value,proto3" json:"sfixed32_value,omitempty"`
+	// sfixed64 kind
+	Sfixed64Value int64 `protobuf:"fixed64,12,opt,name=sfixed64_value,json=sfixed64Value,proto3" json:"sfixed64_value,omitempty"`
+	// bool kind
+	BoolValue bool `protobuf:"varint,13,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`
+	// string kind
+	StringValue string `protobuf:"bytes,14,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+	// bytes kind
+	BytesValue []byte `protobuf:"bytes,15,opt,name=bytes_value,json=bytesValue,proto3" json:"bytes_value,omitempty"`
 }
 
-var (
-	file_examples_simple_v1_simple_proto_rawDescOnce sync.Once
-	file_examples_simple_v1_simple_proto_rawDescData = file_examples_simple_v1_simple_proto_rawDesc
-)
+func (x *ScalarKinds) Reset() {
+	*x = ScalarKinds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_examples_simple_v1_simple_proto_rawDescGZIP() []byte {
-	file_examples_simple_v1_simple_proto_rawDescOnce.Do(func() {
-		file_examples_simple_v1_simple_proto_rawDescData = protoimpl.X.CompressGZIP(file_examples_simple_v1_simple_proto_rawDescData)
-	})
-	return file_examples_simple_v1_simple_proto_rawDescData
+func (x *ScalarKinds) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_examples_simple_v1_simple_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_examples_simple_v1_simple_proto_goTypes = []interface{}{
-	(*Query)(nil),                // 0: examples.simple.v1.Query
-	(*Pagination)(nil),           // 1: examples.simple.v1.Pagination
-	(*ListProfilesRequest)(nil),  // 2: examples.simple.v1.ListProfilesRequest
-	(*ListProfilesResponse)(nil), // 3: examples.simple.v1.ListProfilesResponse
-	(*EchoRequest)(nil),          // 4: examples.simple.v1.EchoRequest
-	(*EchoResponse)(nil),         // 5: examples.simple.v1.EchoResponse
-	(*VersionRequest)(nil),       // 6: examples.simple.v1.VersionRequest
-	(*VersionResponse)(nil),      // 7: examples.simple.v1.VersionResponse
+func (*ScalarKinds) ProtoMessage() {}
+
+func (x *ScalarKinds) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_examples_simple_v1_simple_proto_depIdxs = []int32{
-	5, // 0: examples.simple.v1.Query.echo:type_name -> examples.simple.v1.EchoResponse
-	5, // 1: examples.simple.v1.Query.echo_v2:type_name -> examples.simple.v1.EchoResponse
-	3, // 2: examples.simple.v1.Query.list_profiles:type_name -> examples.simple.v1.ListProfilesResponse
-	1, // 3: examples.simple.v1.ListProfilesRequest.pagination:type_name -> examples.simple.v1.Pagination
-	4, // 4: examples.simple.v1.SimpleService.Echo:input_type -> examples.simple.v1.EchoRequest
-	2, // 5: examples.simple.v1.SimpleService.ListProfiles:input_type -> examples.simple.v1.ListProfilesRequest
-	6, // 6: examples.simple.v1.SimpleService.Version:input_type -> examples.simple.v1.VersionRequest
-	5, // 7: examples.simple.v1.SimpleService.Echo:output_type -> examples.simple.v1.EchoResponse
-	3, // 8: examples.simple.v1.SimpleService.ListProfiles:output_type -> examples.simple.v1.ListProfilesResponse
-	7, // 9: examples.simple.v1.SimpleService.Version:output_type -> examples.simple.v1.VersionResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+// Deprecated: Use ScalarKinds.ProtoReflect.Descriptor instead.
+func (*ScalarKinds) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{8}
 }
 
-func init() { file_examples_simple_v1_simple_proto_init() }
-func file_examples_simple_v1_simple_proto_init() {
-	if File_examples_simple_v1_simple_proto != nil {
-		return
+func (x *ScalarKinds) GetDoubleValue() float64 {
+	if x != nil {
+		return x.DoubleValue
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_examples_simple_v1_simple_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Query); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_examples_simple_v1_simple_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_examples_simple_v1_simple_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProfilesRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_examples_simple_v1_simple_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProfilesResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_examples_simple_v1_simple_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_examples_simple_v1_simple_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_examples_simple_v1_simple_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
+	return 0
+}
+
+func (x *ScalarKinds) GetFloatValue() float32 {
+	if x != nil {
+		return x.FloatValue
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetInt32Value() int32 {
+	if x != nil {
+		return x.Int32Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetInt64Value() int64 {
+	if x != nil {
+		return x.Int64Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetUint32Value() uint32 {
+	if x != nil {
+		return x.Uint32Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetUint64Value() uint64 {
+	if x != nil {
+		return x.Uint64Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetSint32Value() int32 {
+	if x != nil {
+		return x.Sint32Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetSint64Value() int64 {
+	if x != nil {
+		return x.Sint64Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetFixed32Value() uint32 {
+	if x != nil {
+		return x.Fixed32Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetFixed64Value() uint64 {
+	if x != nil {
+		return x.Fixed64Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetSfixed32Value() int32 {
+	if x != nil {
+		return x.Sfixed32Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetSfixed64Value() int64 {
+	if x != nil {
+		return x.Sfixed64Value
+	}
+	return 0
+}
+
+func (x *ScalarKinds) GetBoolValue() bool {
+	if x != nil {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *ScalarKinds) GetStringValue() string {
+	if x != nil {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *ScalarKinds) GetBytesValue() []byte {
+	if x != nil {
+		return x.BytesValue
+	}
+	return nil
+}
+
+// WellKnownKinds holds a field for every well-known type that maps onto a scalar
+type WellKnownKinds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timestamp type
+	TimestampValue *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp_value,json=timestampValue,proto3" json:"timestamp_value,omitempty"`
+	// duration type
+	DurationValue *durationpb.Duration `protobuf:"bytes,2,opt,name=duration_value,json=durationValue,proto3" json:"duration_value,omitempty"`
+	// field mask type
+	FieldMaskValue *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=field_mask_value,json=fieldMaskValue,proto3" json:"field_mask_value,omitempty"`
+	// struct type
+	StructValue *structpb.Struct `protobuf:"bytes,4,opt,name=struct_value,json=structValue,proto3" json:"struct_value,omitempty"`
+	// list value type
+	ListValue *structpb.ListValue `protobuf:"bytes,5,opt,name=list_value,json=listValue,proto3" json:"list_value,omitempty"`
+	// value type
+	ValueValue *structpb.Value `protobuf:"bytes,6,opt,name=value_value,json=valueValue,proto3" json:"value_value,omitempty"`
+	// any type
+	AnyValue *anypb.Any `protobuf:"bytes,7,opt,name=any_value,json=anyValue,proto3" json:"any_value,omitempty"`
+	// empty type
+	EmptyValue *emptypb.Empty `protobuf:"bytes,8,opt,name=empty_value,json=emptyValue,proto3" json:"empty_value,omitempty"`
+	// double wrapper
+	DoubleWrapper *wrapperspb.DoubleValue `protobuf:"bytes,9,opt,name=double_wrapper,json=doubleWrapper,proto3" json:"double_wrapper,omitempty"`
+	// float wrapper
+	FloatWrapper *wrapperspb.FloatValue `protobuf:"bytes,10,opt,name=float_wrapper,json=floatWrapper,proto3" json:"float_wrapper,omitempty"`
+	// int64 wrapper
+	Int64Wrapper *wrapperspb.Int64Value `protobuf:"bytes,11,opt,name=int64_wrapper,json=int64Wrapper,proto3" json:"int64_wrapper,omitempty"`
+	// uint64 wrapper
+	Uint64Wrapper *wrapperspb.UInt64Value `protobuf:"bytes,12,opt,name=uint64_wrapper,json=uint64Wrapper,proto3" json:"uint64_wrapper,omitempty"`
+	// int32 wrapper
+	Int32Wrapper *wrapperspb.Int32Value `protobuf:"bytes,13,opt,name=int32_wrapper,json=int32Wrapper,proto3" json:"int32_wrapper,omitempty"`
+	// uint32 wrapper
+	Uint32Wrapper *wrapperspb.UInt32Value `protobuf:"bytes,14,opt,name=uint32_wrapper,json=uint32Wrapper,proto3" json:"uint32_wrapper,omitempty"`
+	// bool wrapper
+	BoolWrapper *wrapperspb.BoolValue `protobuf:"bytes,15,opt,name=bool_wrapper,json=boolWrapper,proto3" json:"bool_wrapper,omitempty"`
+	// string wrapper
+	StringWrapper *wrapperspb.StringValue `protobuf:"bytes,16,opt,name=string_wrapper,json=stringWrapper,proto3" json:"string_wrapper,omitempty"`
+	// bytes wrapper
+	BytesWrapper *wrapperspb.BytesValue `protobuf:"bytes,17,opt,name=bytes_wrapper,json=bytesWrapper,proto3" json:"bytes_wrapper,omitempty"`
+}
+
+func (x *WellKnownKinds) Reset() {
+	*x = WellKnownKinds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WellKnownKinds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WellKnownKinds) ProtoMessage() {}
+
+func (x *WellKnownKinds) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WellKnownKinds.ProtoReflect.Descriptor instead.
+func (*WellKnownKinds) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WellKnownKinds) GetTimestampValue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimestampValue
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetDurationValue() *durationpb.Duration {
+	if x != nil {
+		return x.DurationValue
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetFieldMaskValue() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMaskValue
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetStructValue() *structpb.Struct {
+	if x != nil {
+		return x.StructValue
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetListValue() *structpb.ListValue {
+	if x != nil {
+		return x.ListValue
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetValueValue() *structpb.Value {
+	if x != nil {
+		return x.ValueValue
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetAnyValue() *anypb.Any {
+	if x != nil {
+		return x.AnyValue
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetEmptyValue() *emptypb.Empty {
+	if x != nil {
+		return x.EmptyValue
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetDoubleWrapper() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.DoubleWrapper
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetFloatWrapper() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.FloatWrapper
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetInt64Wrapper() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Int64Wrapper
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetUint64Wrapper() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Uint64Wrapper
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetInt32Wrapper() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Int32Wrapper
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetUint32Wrapper() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Uint32Wrapper
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetBoolWrapper() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.BoolWrapper
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetStringWrapper() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StringWrapper
+	}
+	return nil
+}
+
+func (x *WellKnownKinds) GetBytesWrapper() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.BytesWrapper
+	}
+	return nil
+}
+
+// MapKinds holds map fields with various key and value kinds
+type MapKinds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// string keys
+	StringKeys map[string]string `protobuf:"bytes,1,rep,name=string_keys,json=stringKeys,proto3" json:"string_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// int32 keys, with message values
+	Int32Keys map[int32]*ScalarKinds `protobuf:"bytes,2,rep,name=int32_keys,json=int32Keys,proto3" json:"int32_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// int64 keys
+	Int64Keys map[int64]string `protobuf:"bytes,3,rep,name=int64_keys,json=int64Keys,proto3" json:"int64_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// bool keys
+	BoolKeys map[bool]string `protobuf:"bytes,4,rep,name=bool_keys,json=boolKeys,proto3" json:"bool_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// map exposed as a json object
+	JsonObject map[string]string `protobuf:"bytes,5,rep,name=json_object,json=jsonObject,proto3" json:"json_object,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MapKinds) Reset() {
+	*x = MapKinds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapKinds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapKinds) ProtoMessage() {}
+
+func (x *MapKinds) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapKinds.ProtoReflect.Descriptor instead.
+func (*MapKinds) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MapKinds) GetStringKeys() map[string]string {
+	if x != nil {
+		return x.StringKeys
+	}
+	return nil
+}
+
+func (x *MapKinds) GetInt32Keys() map[int32]*ScalarKinds {
+	if x != nil {
+		return x.Int32Keys
+	}
+	return nil
+}
+
+func (x *MapKinds) GetInt64Keys() map[int64]string {
+	if x != nil {
+		return x.Int64Keys
+	}
+	return nil
+}
+
+func (x *MapKinds) GetBoolKeys() map[bool]string {
+	if x != nil {
+		return x.BoolKeys
+	}
+	return nil
+}
+
+func (x *MapKinds) GetJsonObject() map[string]string {
+	if x != nil {
+		return x.JsonObject
+	}
+	return nil
+}
+
+// AWSKinds holds fields that are declared as one of the AWS scalars
+type AWSKinds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// declared as an id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// declared as an email address
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// declared as an url
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// declared as a phone number
+	Phone string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	// declared as an ip address
+	IpAddress string `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// declared as a date
+	Date string `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	// declared as a time
+	Time string `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	// declared as a date time
+	DateTime string `protobuf:"bytes,8,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	// declared as a unix timestamp
+	Timestamp int64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *AWSKinds) Reset() {
+	*x = AWSKinds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AWSKinds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AWSKinds) ProtoMessage() {}
+
+func (x *AWSKinds) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AWSKinds.ProtoReflect.Descriptor instead.
+func (*AWSKinds) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AWSKinds) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AWSKinds) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AWSKinds) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AWSKinds) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AWSKinds) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AWSKinds) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AWSKinds) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AWSKinds) GetDateTime() string {
+	if x != nil {
+		return x.DateTime
+	}
+	return ""
+}
+
+func (x *AWSKinds) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// EnumKinds holds fields of various enums
+type EnumKinds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// top-level enum
+	Mood Mood `protobuf:"varint,1,opt,name=mood,proto3,enum=examples.simple.v1.Mood" json:"mood,omitempty"`
+	// nested enum
+	Level EnumKinds_Level `protobuf:"varint,2,opt,name=level,proto3,enum=examples.simple.v1.EnumKinds_Level" json:"level,omitempty"`
+	// enum without zero value
+	Weather Weather `protobuf:"varint,3,opt,name=weather,proto3,enum=examples.simple.v1.Weather" json:"weather,omitempty"`
+	// list of the enum without zero value
+	Forecast []Weather `protobuf:"varint,4,rep,packed,name=forecast,proto3,enum=examples.simple.v1.Weather" json:"forecast,omitempty"`
+}
+
+func (x *EnumKinds) Reset() {
+	*x = EnumKinds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumKinds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumKinds) ProtoMessage() {}
+
+func (x *EnumKinds) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumKinds.ProtoReflect.Descriptor instead.
+func (*EnumKinds) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EnumKinds) GetMood() Mood {
+	if x != nil {
+		return x.Mood
+	}
+	return Mood_MOOD_UNSPECIFIED
+}
+
+func (x *EnumKinds) GetLevel() EnumKinds_Level {
+	if x != nil {
+		return x.Level
+	}
+	return EnumKinds_LEVEL_UNSPECIFIED
+}
+
+func (x *EnumKinds) GetWeather() Weather {
+	if x != nil {
+		return x.Weather
+	}
+	return Weather_WEATHER_UNSPECIFIED
+}
+
+func (x *EnumKinds) GetForecast() []Weather {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+// Acknowledgement is an empty message
+type Acknowledgement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Acknowledgement) Reset() {
+	*x = Acknowledgement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Acknowledgement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Acknowledgement) ProtoMessage() {}
+
+func (x *Acknowledgement) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Acknowledgement.ProtoReflect.Descriptor instead.
+func (*Acknowledgement) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{13}
+}
+
+// EchoKindsRequest holds the scalar kinds to echo
+type EchoKindsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kinds to echo
+	Kinds *ScalarKinds `protobuf:"bytes,1,opt,name=kinds,proto3" json:"kinds,omitempty"`
+	// well-known kinds to echo
+	WellKnown *WellKnownKinds `protobuf:"bytes,2,opt,name=well_known,json=wellKnown,proto3" json:"well_known,omitempty"`
+	// map kinds to echo
+	Maps *MapKinds `protobuf:"bytes,3,opt,name=maps,proto3" json:"maps,omitempty"`
+	// aws kinds to echo
+	Aws *AWSKinds `protobuf:"bytes,4,opt,name=aws,proto3" json:"aws,omitempty"`
+	// enum kinds to echo
+	Enums *EnumKinds `protobuf:"bytes,5,opt,name=enums,proto3" json:"enums,omitempty"`
+	// empty message, omitted from the arguments
+	Ack *Acknowledgement `protobuf:"bytes,6,opt,name=ack,proto3" json:"ack,omitempty"`
+}
+
+func (x *EchoKindsRequest) Reset() {
+	*x = EchoKindsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EchoKindsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoKindsRequest) ProtoMessage() {}
+
+func (x *EchoKindsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoKindsRequest.ProtoReflect.Descriptor instead.
+func (*EchoKindsRequest) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EchoKindsRequest) GetKinds() *ScalarKinds {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *EchoKindsRequest) GetWellKnown() *WellKnownKinds {
+	if x != nil {
+		return x.WellKnown
+	}
+	return nil
+}
+
+func (x *EchoKindsRequest) GetMaps() *MapKinds {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+func (x *EchoKindsRequest) GetAws() *AWSKinds {
+	if x != nil {
+		return x.Aws
+	}
+	return nil
+}
+
+func (x *EchoKindsRequest) GetEnums() *EnumKinds {
+	if x != nil {
+		return x.Enums
+	}
+	return nil
+}
+
+func (x *EchoKindsRequest) GetAck() *Acknowledgement {
+	if x != nil {
+		return x.Ack
+	}
+	return nil
+}
+
+// EchoKindsResponse holds the echoed scalar kinds
+type EchoKindsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// echoed kinds
+	Kinds *ScalarKinds `protobuf:"bytes,1,opt,name=kinds,proto3" json:"kinds,omitempty"`
+	// echoed well-known kinds
+	WellKnown *WellKnownKinds `protobuf:"bytes,2,opt,name=well_known,json=wellKnown,proto3" json:"well_known,omitempty"`
+	// echoed map kinds
+	Maps *MapKinds `protobuf:"bytes,3,opt,name=maps,proto3" json:"maps,omitempty"`
+	// echoed aws kinds
+	Aws *AWSKinds `protobuf:"bytes,4,opt,name=aws,proto3" json:"aws,omitempty"`
+	// echoed enum kinds
+	Enums *EnumKinds `protobuf:"bytes,5,opt,name=enums,proto3" json:"enums,omitempty"`
+	// empty message
+	Ack *Acknowledgement `protobuf:"bytes,6,opt,name=ack,proto3" json:"ack,omitempty"`
+}
+
+func (x *EchoKindsResponse) Reset() {
+	*x = EchoKindsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EchoKindsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoKindsResponse) ProtoMessage() {}
+
+func (x *EchoKindsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoKindsResponse.ProtoReflect.Descriptor instead.
+func (*EchoKindsResponse) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EchoKindsResponse) GetKinds() *ScalarKinds {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *EchoKindsResponse) GetWellKnown() *WellKnownKinds {
+	if x != nil {
+		return x.WellKnown
+	}
+	return nil
+}
+
+func (x *EchoKindsResponse) GetMaps() *MapKinds {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+func (x *EchoKindsResponse) GetAws() *AWSKinds {
+	if x != nil {
+		return x.Aws
+	}
+	return nil
+}
+
+func (x *EchoKindsResponse) GetEnums() *EnumKinds {
+	if x != nil {
+		return x.Enums
+	}
+	return nil
+}
+
+func (x *EchoKindsResponse) GetAck() *Acknowledgement {
+	if x != nil {
+		return x.Ack
+	}
+	return nil
+}
+
+// Notification is an interface that is implemented by the different kinds of notifications
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// text of the notification
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// the kind of notification, which implements the interface
+	//
+	// Types that are assignable to Kind:
+	//	*Notification_Email
+	//	*Notification_Sms
+	Kind isNotification_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Notification) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (m *Notification) GetKind() isNotification_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Notification) GetEmail() *EmailNotification {
+	if x, ok := x.GetKind().(*Notification_Email); ok {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *Notification) GetSms() *SmsNotification {
+	if x, ok := x.GetKind().(*Notification_Sms); ok {
+		return x.Sms
+	}
+	return nil
+}
+
+type isNotification_Kind interface {
+	isNotification_Kind()
+}
+
+type Notification_Email struct {
+	// notification by email
+	Email *EmailNotification `protobuf:"bytes,2,opt,name=email,proto3,oneof"`
+}
+
+type Notification_Sms struct {
+	// notification by text message
+	Sms *SmsNotification `protobuf:"bytes,3,opt,name=sms,proto3,oneof"`
+}
+
+func (*Notification_Email) isNotification_Kind() {}
+
+func (*Notification_Sms) isNotification_Kind() {}
+
+// EmailNotification is a notification that is sent by email
+type EmailNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// text of the notification
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// address that the notification is sent to
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *EmailNotification) Reset() {
+	*x = EmailNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmailNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailNotification) ProtoMessage() {}
+
+func (x *EmailNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailNotification.ProtoReflect.Descriptor instead.
+func (*EmailNotification) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EmailNotification) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *EmailNotification) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// SmsNotification is a notification that is sent as a text message
+type SmsNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// text of the notification
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// phone number that the notification is sent to
+	Phone string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *SmsNotification) Reset() {
+	*x = SmsNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmsNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmsNotification) ProtoMessage() {}
+
+func (x *SmsNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmsNotification.ProtoReflect.Descriptor instead.
+func (*SmsNotification) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SmsNotification) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SmsNotification) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+// ListNotificationsRequest requests the notifications
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// maximum number of notifications to list
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListNotificationsResponse holds notifications of different kinds
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the notifications
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_examples_simple_v1_simple_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_examples_simple_v1_simple_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_examples_simple_v1_simple_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+var File_examples_simple_v1_simple_proto protoreflect.FileDescriptor
+
+var file_examples_simple_v1_simple_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x27, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xe1, 0x03, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x65,
+	0x63, 0x68, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x65, 0x63, 0x68,
+	0x6f, 0x12, 0x52, 0x0a, 0x07, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x76, 0x32, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x17, 0x18, 0x01, 0xd2, 0x44, 0x12, 0x2a, 0x10, 0x75, 0x73, 0x65,
+	0x20, 0x65, 0x63, 0x68, 0x6f, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x52, 0x06, 0x65,
+	0x63, 0x68, 0x6f, 0x56, 0x32, 0x12, 0x4d, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x65,
+	0x63, 0x68, 0x6f, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x65, 0x63, 0x68, 0x6f, 0x4b, 0x69, 0x6e, 0x64,
+	0x73, 0x12, 0x3d, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x53, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x20, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x55, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x07, 0xd2, 0x44, 0x04,
+	0x1a, 0x02, 0x49, 0x44, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x31, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xd2, 0x44, 0x07, 0x22, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x42, 0x0c, 0x0a,
+	0x0a, 0x64, 0x65, 0x63, 0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x0c, 0x45,
+	0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x18, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x63,
+	0x6f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x04, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6c, 0x61,
+	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x11, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x12, 0x52, 0x0b, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x07, 0x52,
+	0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x0d, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x10, 0x52, 0x0d, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xd4, 0x08, 0x0a, 0x0e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x44, 0x0a,
+	0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x0e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x6e, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x61, 0x6e,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x43, 0x0a, 0x0e, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0e, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x40, 0x0a,
+	0x0d, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12,
+	0x43, 0x0a, 0x0e, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6f, 0x6f, 0x6c, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x22, 0xe8, 0x05, 0x0a, 0x08, 0x4d,
+	0x61, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x4d, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65,
+	0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x4b,
+	0x69, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x47,
+	0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x62,
+	0x6f, 0x6f, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x54, 0x0a, 0x0b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x05, 0xd2, 0x44, 0x02, 0x10,
+	0x01, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a, 0x0e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x42, 0x6f, 0x6f,
+	0x6c, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x4a, 0x73, 0x6f, 0x6e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe2, 0x02, 0x0a, 0x08, 0x41, 0x57, 0x53, 0x4b, 0x69, 0x6e,
+	0x64, 0x73, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xd2, 0x44, 0x04, 0x1a, 0x02, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x1a,
+	0x08, 0x41, 0x57, 0x53, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xd2,
+	0x44, 0x08, 0x1a, 0x06, 0x41, 0x57, 0x53, 0x55, 0x52, 0x4c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x23, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0xd2, 0x44, 0x0a, 0x1a, 0x08, 0x41, 0x57, 0x53, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xd2, 0x44, 0x0e, 0x1a, 0x0c, 0x41,
+	0x57, 0x53, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x69, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xd2, 0x44, 0x09, 0x1a, 0x07, 0x41, 0x57, 0x53, 0x44, 0x61,
+	0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xd2, 0x44, 0x09, 0x1a, 0x07, 0x41, 0x57, 0x53,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xd2,
+	0x44, 0x0d, 0x1a, 0x0b, 0x41, 0x57, 0x53, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0xd2, 0x44,
+	0x0e, 0x1a, 0x0c, 0x41, 0x57, 0x53, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xaf, 0x02, 0x0a, 0x09, 0x45,
+	0x6e, 0x75, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x6d, 0x6f, 0x6f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6f, 0x64,
+	0x52, 0x04, 0x6d, 0x6f, 0x6f, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4b,
+	0x69, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x35, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52,
+	0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x22, 0x49, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02,
+	0x1a, 0x0a, 0xca, 0x44, 0x07, 0x12, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x11, 0x0a, 0x0f,
+	0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xda, 0x02, 0x0a, 0x10, 0x45, 0x63, 0x68, 0x6f, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x4b,
+	0x69, 0x6e, 0x64, 0x73, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x77,
+	0x65, 0x6c, 0x6c, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x69,
+	0x6e, 0x64, 0x73, 0x52, 0x09, 0x77, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x30,
+	0x0a, 0x04, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x04, 0x6d, 0x61, 0x70, 0x73,
+	0x12, 0x2e, 0x0a, 0x03, 0x61, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x57, 0x53, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x03, 0x61, 0x77, 0x73,
+	0x12, 0x33, 0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x05,
+	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x22, 0xdb, 0x02, 0x0a,
+	0x11, 0x45, 0x63, 0x68, 0x6f, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x4b, 0x69, 0x6e,
+	0x64, 0x73, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x77, 0x65, 0x6c,
+	0x6c, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
+	0x73, 0x52, 0x09, 0x77, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x30, 0x0a, 0x04,
+	0x6d, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x70, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x04, 0x6d, 0x61, 0x70, 0x73, 0x12, 0x2e,
+	0x0a, 0x03, 0x61, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x57, 0x53, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x03, 0x61, 0x77, 0x73, 0x12, 0x33,
+	0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x05, 0x65, 0x6e,
+	0x75, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x3d, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x37,
+	0x0a, 0x03, 0x73, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6d, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x03, 0x73, 0x6d, 0x73, 0x3a, 0x05, 0xc2, 0x44, 0x02, 0x20, 0x01, 0x42, 0x06,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x50, 0x0a, 0x11, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x27, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0xd2, 0x44, 0x0a, 0x1a, 0x08, 0x41, 0x57, 0x53, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4a, 0x0a, 0x0f, 0x53, 0x6d, 0x73, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x23, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0xd2, 0x44, 0x0a, 0x1a, 0x08, 0x41, 0x57, 0x53, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x22, 0x39, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xd2, 0x44, 0x04, 0x42, 0x02, 0x31, 0x30, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x63, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x66, 0x0a, 0x04, 0x4d, 0x6f, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x10,
+	0x4d, 0x4f, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x4f, 0x44, 0x5f, 0x48, 0x41, 0x50, 0x50, 0x59,
+	0x10, 0x01, 0x12, 0x26, 0x0a, 0x0b, 0x4d, 0x4f, 0x4f, 0x44, 0x5f, 0x4a, 0x4f, 0x59, 0x46, 0x55,
+	0x4c, 0x10, 0x01, 0x1a, 0x15, 0x08, 0x01, 0xba, 0x44, 0x10, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x20,
+	0x4d, 0x4f, 0x4f, 0x44, 0x5f, 0x48, 0x41, 0x50, 0x50, 0x59, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f,
+	0x4f, 0x44, 0x5f, 0x53, 0x41, 0x44, 0x10, 0x02, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0x4f, 0x0a, 0x07,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x45, 0x41, 0x54, 0x48,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x11, 0x0a, 0x0d, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x53, 0x55, 0x4e, 0x4e,
+	0x59, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x5f, 0x52,
+	0x41, 0x49, 0x4e, 0x59, 0x10, 0x02, 0x1a, 0x05, 0xca, 0x44, 0x02, 0x08, 0x01, 0x32, 0xd9, 0x05,
+	0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x69, 0x0a, 0x04, 0x45, 0x63, 0x68, 0x6f, 0x12, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63,
+	0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0xda, 0x44, 0x1b, 0x1a,
+	0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x65, 0x63, 0x68, 0x6f, 0x1a, 0x0d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x76, 0x32, 0x12, 0x7b, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0xda,
+	0x44, 0x15, 0x1a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x79, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x88, 0x02, 0x01,
+	0xda, 0x44, 0x1f, 0x1a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x6f, 0x0a, 0x09, 0x45, 0x63, 0x68, 0x6f, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12,
+	0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x4b,
+	0x69, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0xda, 0x44,
+	0x12, 0x1a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0xda, 0x44, 0x15, 0x1a, 0x13, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x67, 0x0a, 0x08, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0x14, 0xda, 0x44, 0x11, 0x1a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0xde, 0x01, 0x0a, 0x16, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x72, 0x65, 0x77, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x61, 0x70, 0x70, 0x73, 0x79, 0x6e, 0x63, 0x2d, 0x67, 0x6f,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2f,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x53, 0x58, 0xaa, 0x02, 0x12, 0x45, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x12,
+	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x1e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x5c, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x3a, 0x3a,
+	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_examples_simple_v1_simple_proto_rawDescOnce sync.Once
+	file_examples_simple_v1_simple_proto_rawDescData = file_examples_simple_v1_simple_proto_rawDesc
+)
+
+func file_examples_simple_v1_simple_proto_rawDescGZIP() []byte {
+	file_examples_simple_v1_simple_proto_rawDescOnce.Do(func() {
+		file_examples_simple_v1_simple_proto_rawDescData = protoimpl.X.CompressGZIP(file_examples_simple_v1_simple_proto_rawDescData)
+	})
+	return file_examples_simple_v1_simple_proto_rawDescData
+}
+
+var file_examples_simple_v1_simple_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_examples_simple_v1_simple_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_examples_simple_v1_simple_proto_goTypes = []interface{}{
+	(Mood)(0),                         // 0: examples.simple.v1.Mood
+	(Weather)(0),                      // 1: examples.simple.v1.Weather
+	(EnumKinds_Level)(0),              // 2: examples.simple.v1.EnumKinds.Level
+	(*Query)(nil),                     // 3: examples.simple.v1.Query
+	(*Pagination)(nil),                // 4: examples.simple.v1.Pagination
+	(*ListProfilesRequest)(nil),       // 5: examples.simple.v1.ListProfilesRequest
+	(*ListProfilesResponse)(nil),      // 6: examples.simple.v1.ListProfilesResponse
+	(*EchoRequest)(nil),               // 7: examples.simple.v1.EchoRequest
+	(*EchoResponse)(nil),              // 8: examples.simple.v1.EchoResponse
+	(*VersionRequest)(nil),            // 9: examples.simple.v1.VersionRequest
+	(*VersionResponse)(nil),           // 10: examples.simple.v1.VersionResponse
+	(*ScalarKinds)(nil),               // 11: examples.simple.v1.ScalarKinds
+	(*WellKnownKinds)(nil),            // 12: examples.simple.v1.WellKnownKinds
+	(*MapKinds)(nil),                  // 13: examples.simple.v1.MapKinds
+	(*AWSKinds)(nil),                  // 14: examples.simple.v1.AWSKinds
+	(*EnumKinds)(nil),                 // 15: examples.simple.v1.EnumKinds
+	(*Acknowledgement)(nil),           // 16: examples.simple.v1.Acknowledgement
+	(*EchoKindsRequest)(nil),          // 17: examples.simple.v1.EchoKindsRequest
+	(*EchoKindsResponse)(nil),         // 18: examples.simple.v1.EchoKindsResponse
+	(*Notification)(nil),              // 19: examples.simple.v1.Notification
+	(*EmailNotification)(nil),         // 20: examples.simple.v1.EmailNotification
+	(*SmsNotification)(nil),           // 21: examples.simple.v1.SmsNotification
+	(*ListNotificationsRequest)(nil),  // 22: examples.simple.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil), // 23: examples.simple.v1.ListNotificationsResponse
+	nil,                               // 24: examples.simple.v1.MapKinds.StringKeysEntry
+	nil,                               // 25: examples.simple.v1.MapKinds.Int32KeysEntry
+	nil,                               // 26: examples.simple.v1.MapKinds.Int64KeysEntry
+	nil,                               // 27: examples.simple.v1.MapKinds.BoolKeysEntry
+	nil,                               // 28: examples.simple.v1.MapKinds.JsonObjectEntry
+	(*v1.PageInfo)(nil),               // 29: examples.pagination.v1.PageInfo
+	(*timestamppb.Timestamp)(nil),     // 30: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 31: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),     // 32: google.protobuf.FieldMask
+	(*structpb.Struct)(nil),           // 33: google.protobuf.Struct
+	(*structpb.ListValue)(nil),        // 34: google.protobuf.ListValue
+	(*structpb.Value)(nil),            // 35: google.protobuf.Value
+	(*anypb.Any)(nil),                 // 36: google.protobuf.Any
+	(*emptypb.Empty)(nil),             // 37: google.protobuf.Empty
+	(*wrapperspb.DoubleValue)(nil),    // 38: google.protobuf.DoubleValue
+	(*wrapperspb.FloatValue)(nil),     // 39: google.protobuf.FloatValue
+	(*wrapperspb.Int64Value)(nil),     // 40: google.protobuf.Int64Value
+	(*wrapperspb.UInt64Value)(nil),    // 41: google.protobuf.UInt64Value
+	(*wrapperspb.Int32Value)(nil),     // 42: google.protobuf.Int32Value
+	(*wrapperspb.UInt32Value)(nil),    // 43: google.protobuf.UInt32Value
+	(*wrapperspb.BoolValue)(nil),      // 44: google.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil),    // 45: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),     // 46: google.protobuf.BytesValue
+	(*v1.PageRequest)(nil),            // 47: examples.pagination.v1.PageRequest
+}
+var file_examples_simple_v1_simple_proto_depIdxs = []int32{
+	8,  // 0: examples.simple.v1.Query.echo:type_name -> examples.simple.v1.EchoResponse
+	8,  // 1: examples.simple.v1.Query.echo_v2:type_name -> examples.simple.v1.EchoResponse
+	6,  // 2: examples.simple.v1.Query.list_profiles:type_name -> examples.simple.v1.ListProfilesResponse
+	18, // 3: examples.simple.v1.Query.echo_kinds:type_name -> examples.simple.v1.EchoKindsResponse
+	29, // 4: examples.simple.v1.Query.next_page:type_name -> examples.pagination.v1.PageInfo
+	23, // 5: examples.simple.v1.Query.notifications:type_name -> examples.simple.v1.ListNotificationsResponse
+	4,  // 6: examples.simple.v1.ListProfilesRequest.pagination:type_name -> examples.simple.v1.Pagination
+	30, // 7: examples.simple.v1.WellKnownKinds.timestamp_value:type_name -> google.protobuf.Timestamp
+	31, // 8: examples.simple.v1.WellKnownKinds.duration_value:type_name -> google.protobuf.Duration
+	32, // 9: examples.simple.v1.WellKnownKinds.field_mask_value:type_name -> google.protobuf.FieldMask
+	33, // 10: examples.simple.v1.WellKnownKinds.struct_value:type_name -> google.protobuf.Struct
+	34, // 11: examples.simple.v1.WellKnownKinds.list_value:type_name -> google.protobuf.ListValue
+	35, // 12: examples.simple.v1.WellKnownKinds.value_value:type_name -> google.protobuf.Value
+	36, // 13: examples.simple.v1.WellKnownKinds.any_value:type_name -> google.protobuf.Any
+	37, // 14: examples.simple.v1.WellKnownKinds.empty_value:type_name -> google.protobuf.Empty
+	38, // 15: examples.simple.v1.WellKnownKinds.double_wrapper:type_name -> google.protobuf.DoubleValue
+	39, // 16: examples.simple.v1.WellKnownKinds.float_wrapper:type_name -> google.protobuf.FloatValue
+	40, // 17: examples.simple.v1.WellKnownKinds.int64_wrapper:type_name -> google.protobuf.Int64Value
+	41, // 18: examples.simple.v1.WellKnownKinds.uint64_wrapper:type_name -> google.protobuf.UInt64Value
+	42, // 19: examples.simple.v1.WellKnownKinds.int32_wrapper:type_name -> google.protobuf.Int32Value
+	43, // 20: examples.simple.v1.WellKnownKinds.uint32_wrapper:type_name -> google.protobuf.UInt32Value
+	44, // 21: examples.simple.v1.WellKnownKinds.bool_wrapper:type_name -> google.protobuf.BoolValue
+	45, // 22: examples.simple.v1.WellKnownKinds.string_wrapper:type_name -> google.protobuf.StringValue
+	46, // 23: examples.simple.v1.WellKnownKinds.bytes_wrapper:type_name -> google.protobuf.BytesValue
+	24, // 24: examples.simple.v1.MapKinds.string_keys:type_name -> examples.simple.v1.MapKinds.StringKeysEntry
+	25, // 25: examples.simple.v1.MapKinds.int32_keys:type_name -> examples.simple.v1.MapKinds.Int32KeysEntry
+	26, // 26: examples.simple.v1.MapKinds.int64_keys:type_name -> examples.simple.v1.MapKinds.Int64KeysEntry
+	27, // 27: examples.simple.v1.MapKinds.bool_keys:type_name -> examples.simple.v1.MapKinds.BoolKeysEntry
+	28, // 28: examples.simple.v1.MapKinds.json_object:type_name -> examples.simple.v1.MapKinds.JsonObjectEntry
+	0,  // 29: examples.simple.v1.EnumKinds.mood:type_name -> examples.simple.v1.Mood
+	2,  // 30: examples.simple.v1.EnumKinds.level:type_name -> examples.simple.v1.EnumKinds.Level
+	1,  // 31: examples.simple.v1.EnumKinds.weather:type_name -> examples.simple.v1.Weather
+	1,  // 32: examples.simple.v1.EnumKinds.forecast:type_name -> examples.simple.v1.Weather
+	11, // 33: examples.simple.v1.EchoKindsRequest.kinds:type_name -> examples.simple.v1.ScalarKinds
+	12, // 34: examples.simple.v1.EchoKindsRequest.well_known:type_name -> examples.simple.v1.WellKnownKinds
+	13, // 35: examples.simple.v1.EchoKindsRequest.maps:type_name -> examples.simple.v1.MapKinds
+	14, // 36: examples.simple.v1.EchoKindsRequest.aws:type_name -> examples.simple.v1.AWSKinds
+	15, // 37: examples.simple.v1.EchoKindsRequest.enums:type_name -> examples.simple.v1.EnumKinds
+	16, // 38: examples.simple.v1.EchoKindsRequest.ack:type_name -> examples.simple.v1.Acknowledgement
+	11, // 39: examples.simple.v1.EchoKindsResponse.kinds:type_name -> examples.simple.v1.ScalarKinds
+	12, // 40: examples.simple.v1.EchoKindsResponse.well_known:type_name -> examples.simple.v1.WellKnownKinds
+	13, // 41: examples.simple.v1.EchoKindsResponse.maps:type_name -> examples.simple.v1.MapKinds
+	14, // 42: examples.simple.v1.EchoKindsResponse.aws:type_name -> examples.simple.v1.AWSKinds
+	15, // 43: examples.simple.v1.EchoKindsResponse.enums:type_name -> examples.simple.v1.EnumKinds
+	16, // 44: examples.simple.v1.EchoKindsResponse.ack:type_name -> examples.simple.v1.Acknowledgement
+	20, // 45: examples.simple.v1.Notification.email:type_name -> examples.simple.v1.EmailNotification
+	21, // 46: examples.simple.v1.Notification.sms:type_name -> examples.simple.v1.SmsNotification
+	19, // 47: examples.simple.v1.ListNotificationsResponse.notifications:type_name -> examples.simple.v1.Notification
+	11, // 48: examples.simple.v1.MapKinds.Int32KeysEntry.value:type_name -> examples.simple.v1.ScalarKinds
+	7,  // 49: examples.simple.v1.SimpleService.Echo:input_type -> examples.simple.v1.EchoRequest
+	5,  // 50: examples.simple.v1.SimpleService.ListProfiles:input_type -> examples.simple.v1.ListProfilesRequest
+	9,  // 51: examples.simple.v1.SimpleService.Version:input_type -> examples.simple.v1.VersionRequest
+	17, // 52: examples.simple.v1.SimpleService.EchoKinds:input_type -> examples.simple.v1.EchoKindsRequest
+	22, // 53: examples.simple.v1.SimpleService.ListNotifications:input_type -> examples.simple.v1.ListNotificationsRequest
+	47, // 54: examples.simple.v1.SimpleService.NextPage:input_type -> examples.pagination.v1.PageRequest
+	8,  // 55: examples.simple.v1.SimpleService.Echo:output_type -> examples.simple.v1.EchoResponse
+	6,  // 56: examples.simple.v1.SimpleService.ListProfiles:output_type -> examples.simple.v1.ListProfilesResponse
+	10, // 57: examples.simple.v1.SimpleService.Version:output_type -> examples.simple.v1.VersionResponse
+	18, // 58: examples.simple.v1.SimpleService.EchoKinds:output_type -> examples.simple.v1.EchoKindsResponse
+	23, // 59: examples.simple.v1.SimpleService.ListNotifications:output_type -> examples.simple.v1.ListNotificationsResponse
+	29, // 60: examples.simple.v1.SimpleService.NextPage:output_type -> examples.pagination.v1.PageInfo
+	55, // [55:61] is the sub-list for method output_type
+	49, // [49:55] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
+}
+
+func init() { file_examples_simple_v1_simple_proto_init() }
+func file_examples_simple_v1_simple_proto_init() {
+	if File_examples_simple_v1_simple_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_examples_simple_v1_simple_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Query); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
 			default:
 				return nil
 			}
@@ -659,19 +2597,188 @@ func file_examples_simple_v1_simple_proto_init() {
 				return nil
 			}
 		}
+		file_examples_simple_v1_simple_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScalarKinds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WellKnownKinds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapKinds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AWSKinds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumKinds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Acknowledgement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoKindsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoKindsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmsNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_examples_simple_v1_simple_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_examples_simple_v1_simple_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*EchoRequest_Prefix)(nil),
+		(*EchoRequest_Repeat)(nil),
+	}
+	file_examples_simple_v1_simple_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*EchoResponse_Prefix)(nil),
+		(*EchoResponse_Repeat)(nil),
+	}
+	file_examples_simple_v1_simple_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*Notification_Email)(nil),
+		(*Notification_Sms)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_examples_simple_v1_simple_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_examples_simple_v1_simple_proto_goTypes,
 		DependencyIndexes: file_examples_simple_v1_simple_proto_depIdxs,
+		EnumInfos:         file_examples_simple_v1_simple_proto_enumTypes,
 		MessageInfos:      file_examples_simple_v1_simple_proto_msgTypes,
 	}.Build()
 	File_examples_simple_v1_simple_proto = out.File
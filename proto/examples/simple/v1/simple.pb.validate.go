@@ -145,6 +145,93 @@ func (m *Query) validate(all bool) error {
 
 	// no validation rules for LatestVersion
 
+	if all {
+		switch v := interface{}(m.GetEchoKinds()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryValidationError{
+					field:  "EchoKinds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryValidationError{
+					field:  "EchoKinds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEchoKinds()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryValidationError{
+				field:  "EchoKinds",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryValidationError{
+					field:  "NextPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryValidationError{
+					field:  "NextPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryValidationError{
+				field:  "NextPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNotifications()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryValidationError{
+					field:  "Notifications",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryValidationError{
+					field:  "Notifications",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotifications()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryValidationError{
+				field:  "Notifications",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return QueryMultiError(errors)
 	}
@@ -476,6 +563,8 @@ func (m *ListProfilesResponse) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for TotalProfiles
+
 	if len(errors) > 0 {
 		return ListProfilesResponseMultiError(errors)
 	}
@@ -580,6 +669,37 @@ func (m *EchoRequest) validate(all bool) error {
 
 	// no validation rules for Message
 
+	// no validation rules for Leader
+
+	switch v := m.Decoration.(type) {
+	case *EchoRequest_Prefix:
+		if v == nil {
+			err := EchoRequestValidationError{
+				field:  "Decoration",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Prefix
+	case *EchoRequest_Repeat:
+		if v == nil {
+			err := EchoRequestValidationError{
+				field:  "Decoration",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Repeat
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
 		return EchoRequestMultiError(errors)
 	}
@@ -681,6 +801,35 @@ func (m *EchoResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
+	switch v := m.Decoration.(type) {
+	case *EchoResponse_Prefix:
+		if v == nil {
+			err := EchoResponseValidationError{
+				field:  "Decoration",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Prefix
+	case *EchoResponse_Repeat:
+		if v == nil {
+			err := EchoResponseValidationError{
+				field:  "Decoration",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Repeat
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
 		return EchoResponseMultiError(errors)
 	}
@@ -959,3 +1108,2388 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = VersionResponseValidationError{}
+
+// Validate checks the field values on ScalarKinds with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ScalarKinds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScalarKinds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScalarKindsMultiError, or
+// nil if none found.
+func (m *ScalarKinds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScalarKinds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DoubleValue
+
+	// no validation rules for FloatValue
+
+	// no validation rules for Int32Value
+
+	// no validation rules for Int64Value
+
+	// no validation rules for Uint32Value
+
+	// no validation rules for Uint64Value
+
+	// no validation rules for Sint32Value
+
+	// no validation rules for Sint64Value
+
+	// no validation rules for Fixed32Value
+
+	// no validation rules for Fixed64Value
+
+	// no validation rules for Sfixed32Value
+
+	// no validation rules for Sfixed64Value
+
+	// no validation rules for BoolValue
+
+	// no validation rules for StringValue
+
+	// no validation rules for BytesValue
+
+	if len(errors) > 0 {
+		return ScalarKindsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScalarKindsMultiError is an error wrapping multiple validation errors
+// returned by ScalarKinds.ValidateAll() if the designated constraints aren't met.
+type ScalarKindsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScalarKindsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScalarKindsMultiError) AllErrors() []error { return m }
+
+// ScalarKindsValidationError is the validation error returned by
+// ScalarKinds.Validate if the designated constraints aren't met.
+type ScalarKindsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScalarKindsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScalarKindsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScalarKindsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScalarKindsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScalarKindsValidationError) ErrorName() string { return "ScalarKindsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScalarKindsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScalarKinds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScalarKindsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScalarKindsValidationError{}
+
+// Validate checks the field values on WellKnownKinds with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WellKnownKinds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WellKnownKinds with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WellKnownKindsMultiError,
+// or nil if none found.
+func (m *WellKnownKinds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WellKnownKinds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTimestampValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "TimestampValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "TimestampValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestampValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "TimestampValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDurationValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "DurationValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "DurationValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDurationValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "DurationValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFieldMaskValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "FieldMaskValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "FieldMaskValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFieldMaskValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "FieldMaskValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStructValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "StructValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "StructValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStructValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "StructValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetListValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "ListValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "ListValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetListValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "ListValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValueValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "ValueValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "ValueValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValueValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "ValueValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAnyValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "AnyValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "AnyValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnyValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "AnyValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEmptyValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "EmptyValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "EmptyValue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmptyValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "EmptyValue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDoubleWrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "DoubleWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "DoubleWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDoubleWrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "DoubleWrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFloatWrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "FloatWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "FloatWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFloatWrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "FloatWrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInt64Wrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "Int64Wrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "Int64Wrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInt64Wrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "Int64Wrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUint64Wrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "Uint64Wrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "Uint64Wrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUint64Wrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "Uint64Wrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInt32Wrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "Int32Wrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "Int32Wrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInt32Wrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "Int32Wrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUint32Wrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "Uint32Wrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "Uint32Wrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUint32Wrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "Uint32Wrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBoolWrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "BoolWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "BoolWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBoolWrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "BoolWrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStringWrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "StringWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "StringWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStringWrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "StringWrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBytesWrapper()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "BytesWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WellKnownKindsValidationError{
+					field:  "BytesWrapper",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBytesWrapper()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WellKnownKindsValidationError{
+				field:  "BytesWrapper",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WellKnownKindsMultiError(errors)
+	}
+
+	return nil
+}
+
+// WellKnownKindsMultiError is an error wrapping multiple validation errors
+// returned by WellKnownKinds.ValidateAll() if the designated constraints
+// aren't met.
+type WellKnownKindsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WellKnownKindsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WellKnownKindsMultiError) AllErrors() []error { return m }
+
+// WellKnownKindsValidationError is the validation error returned by
+// WellKnownKinds.Validate if the designated constraints aren't met.
+type WellKnownKindsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WellKnownKindsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WellKnownKindsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WellKnownKindsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WellKnownKindsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WellKnownKindsValidationError) ErrorName() string { return "WellKnownKindsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WellKnownKindsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWellKnownKinds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WellKnownKindsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WellKnownKindsValidationError{}
+
+// Validate checks the field values on MapKinds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MapKinds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MapKinds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MapKindsMultiError, or nil
+// if none found.
+func (m *MapKinds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MapKinds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StringKeys
+
+	{
+		sorted_keys := make([]int32, len(m.GetInt32Keys()))
+		i := 0
+		for key := range m.GetInt32Keys() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetInt32Keys()[key]
+			_ = val
+
+			// no validation rules for Int32Keys[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, MapKindsValidationError{
+							field:  fmt.Sprintf("Int32Keys[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, MapKindsValidationError{
+							field:  fmt.Sprintf("Int32Keys[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return MapKindsValidationError{
+						field:  fmt.Sprintf("Int32Keys[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Int64Keys
+
+	// no validation rules for BoolKeys
+
+	// no validation rules for JsonObject
+
+	if len(errors) > 0 {
+		return MapKindsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MapKindsMultiError is an error wrapping multiple validation errors returned
+// by MapKinds.ValidateAll() if the designated constraints aren't met.
+type MapKindsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MapKindsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MapKindsMultiError) AllErrors() []error { return m }
+
+// MapKindsValidationError is the validation error returned by
+// MapKinds.Validate if the designated constraints aren't met.
+type MapKindsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MapKindsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MapKindsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MapKindsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MapKindsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MapKindsValidationError) ErrorName() string { return "MapKindsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MapKindsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMapKinds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MapKindsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MapKindsValidationError{}
+
+// Validate checks the field values on AWSKinds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AWSKinds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AWSKinds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AWSKindsMultiError, or nil
+// if none found.
+func (m *AWSKinds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AWSKinds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Email
+
+	// no validation rules for Url
+
+	// no validation rules for Phone
+
+	// no validation rules for IpAddress
+
+	// no validation rules for Date
+
+	// no validation rules for Time
+
+	// no validation rules for DateTime
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return AWSKindsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AWSKindsMultiError is an error wrapping multiple validation errors returned
+// by AWSKinds.ValidateAll() if the designated constraints aren't met.
+type AWSKindsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AWSKindsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AWSKindsMultiError) AllErrors() []error { return m }
+
+// AWSKindsValidationError is the validation error returned by
+// AWSKinds.Validate if the designated constraints aren't met.
+type AWSKindsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AWSKindsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AWSKindsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AWSKindsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AWSKindsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AWSKindsValidationError) ErrorName() string { return "AWSKindsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AWSKindsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAWSKinds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AWSKindsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AWSKindsValidationError{}
+
+// Validate checks the field values on EnumKinds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EnumKinds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnumKinds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EnumKindsMultiError, or nil
+// if none found.
+func (m *EnumKinds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnumKinds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mood
+
+	// no validation rules for Level
+
+	// no validation rules for Weather
+
+	if len(errors) > 0 {
+		return EnumKindsMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnumKindsMultiError is an error wrapping multiple validation errors returned
+// by EnumKinds.ValidateAll() if the designated constraints aren't met.
+type EnumKindsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnumKindsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnumKindsMultiError) AllErrors() []error { return m }
+
+// EnumKindsValidationError is the validation error returned by
+// EnumKinds.Validate if the designated constraints aren't met.
+type EnumKindsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnumKindsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnumKindsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnumKindsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnumKindsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnumKindsValidationError) ErrorName() string { return "EnumKindsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnumKindsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnumKinds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnumKindsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnumKindsValidationError{}
+
+// Validate checks the field values on Acknowledgement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Acknowledgement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Acknowledgement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcknowledgementMultiError, or nil if none found.
+func (m *Acknowledgement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Acknowledgement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AcknowledgementMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcknowledgementMultiError is an error wrapping multiple validation errors
+// returned by Acknowledgement.ValidateAll() if the designated constraints
+// aren't met.
+type AcknowledgementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcknowledgementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcknowledgementMultiError) AllErrors() []error { return m }
+
+// AcknowledgementValidationError is the validation error returned by
+// Acknowledgement.Validate if the designated constraints aren't met.
+type AcknowledgementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcknowledgementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcknowledgementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcknowledgementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcknowledgementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcknowledgementValidationError) ErrorName() string { return "AcknowledgementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AcknowledgementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcknowledgement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcknowledgementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcknowledgementValidationError{}
+
+// Validate checks the field values on EchoKindsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EchoKindsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EchoKindsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EchoKindsRequestMultiError, or nil if none found.
+func (m *EchoKindsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EchoKindsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKinds()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Kinds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Kinds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKinds()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsRequestValidationError{
+				field:  "Kinds",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWellKnown()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "WellKnown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "WellKnown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWellKnown()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsRequestValidationError{
+				field:  "WellKnown",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaps()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Maps",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Maps",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaps()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsRequestValidationError{
+				field:  "Maps",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAws()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Aws",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Aws",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAws()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsRequestValidationError{
+				field:  "Aws",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnums()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Enums",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Enums",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnums()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsRequestValidationError{
+				field:  "Enums",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Ack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsRequestValidationError{
+					field:  "Ack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsRequestValidationError{
+				field:  "Ack",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EchoKindsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EchoKindsRequestMultiError is an error wrapping multiple validation errors
+// returned by EchoKindsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EchoKindsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EchoKindsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EchoKindsRequestMultiError) AllErrors() []error { return m }
+
+// EchoKindsRequestValidationError is the validation error returned by
+// EchoKindsRequest.Validate if the designated constraints aren't met.
+type EchoKindsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EchoKindsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EchoKindsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EchoKindsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EchoKindsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EchoKindsRequestValidationError) ErrorName() string { return "EchoKindsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EchoKindsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEchoKindsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EchoKindsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EchoKindsRequestValidationError{}
+
+// Validate checks the field values on EchoKindsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EchoKindsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EchoKindsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EchoKindsResponseMultiError, or nil if none found.
+func (m *EchoKindsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EchoKindsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKinds()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Kinds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Kinds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKinds()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsResponseValidationError{
+				field:  "Kinds",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWellKnown()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "WellKnown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "WellKnown",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWellKnown()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsResponseValidationError{
+				field:  "WellKnown",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaps()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Maps",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Maps",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaps()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsResponseValidationError{
+				field:  "Maps",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAws()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Aws",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Aws",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAws()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsResponseValidationError{
+				field:  "Aws",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnums()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Enums",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Enums",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnums()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsResponseValidationError{
+				field:  "Enums",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Ack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EchoKindsResponseValidationError{
+					field:  "Ack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EchoKindsResponseValidationError{
+				field:  "Ack",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EchoKindsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EchoKindsResponseMultiError is an error wrapping multiple validation errors
+// returned by EchoKindsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EchoKindsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EchoKindsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EchoKindsResponseMultiError) AllErrors() []error { return m }
+
+// EchoKindsResponseValidationError is the validation error returned by
+// EchoKindsResponse.Validate if the designated constraints aren't met.
+type EchoKindsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EchoKindsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EchoKindsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EchoKindsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EchoKindsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EchoKindsResponseValidationError) ErrorName() string {
+	return "EchoKindsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EchoKindsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEchoKindsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EchoKindsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EchoKindsResponseValidationError{}
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationMultiError, or
+// nil if none found.
+func (m *Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	switch v := m.Kind.(type) {
+	case *Notification_Email:
+		if v == nil {
+			err := NotificationValidationError{
+				field:  "Kind",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEmail()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  "Email",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  "Email",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEmail()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationValidationError{
+					field:  "Email",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Notification_Sms:
+		if v == nil {
+			err := NotificationValidationError{
+				field:  "Kind",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSms()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  "Sms",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  "Sms",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSms()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationValidationError{
+					field:  "Sms",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationMultiError is an error wrapping multiple validation errors
+// returned by Notification.ValidateAll() if the designated constraints aren't met.
+type NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMultiError) AllErrors() []error { return m }
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// Validate checks the field values on EmailNotification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EmailNotification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EmailNotification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EmailNotificationMultiError, or nil if none found.
+func (m *EmailNotification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EmailNotification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return EmailNotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// EmailNotificationMultiError is an error wrapping multiple validation errors
+// returned by EmailNotification.ValidateAll() if the designated constraints
+// aren't met.
+type EmailNotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EmailNotificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EmailNotificationMultiError) AllErrors() []error { return m }
+
+// EmailNotificationValidationError is the validation error returned by
+// EmailNotification.Validate if the designated constraints aren't met.
+type EmailNotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmailNotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmailNotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmailNotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmailNotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmailNotificationValidationError) ErrorName() string {
+	return "EmailNotificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmailNotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmailNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmailNotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmailNotificationValidationError{}
+
+// Validate checks the field values on SmsNotification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SmsNotification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SmsNotification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SmsNotificationMultiError, or nil if none found.
+func (m *SmsNotification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SmsNotification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	// no validation rules for Phone
+
+	if len(errors) > 0 {
+		return SmsNotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SmsNotificationMultiError is an error wrapping multiple validation errors
+// returned by SmsNotification.ValidateAll() if the designated constraints
+// aren't met.
+type SmsNotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SmsNotificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SmsNotificationMultiError) AllErrors() []error { return m }
+
+// SmsNotificationValidationError is the validation error returned by
+// SmsNotification.Validate if the designated constraints aren't met.
+type SmsNotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SmsNotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SmsNotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SmsNotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SmsNotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SmsNotificationValidationError) ErrorName() string { return "SmsNotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SmsNotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSmsNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SmsNotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SmsNotificationValidationError{}
+
+// Validate checks the field values on ListNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsRequestMultiError, or nil if none found.
+func (m *ListNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.Validate if the designated constraints aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// Validate checks the field values on ListNotificationsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsResponseMultiError, or nil if none found.
+func (m *ListNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// ListNotificationsResponseValidationError is the validation error returned by
+// ListNotificationsResponse.Validate if the designated constraints aren't met.
+type ListNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsResponseValidationError) ErrorName() string {
+	return "ListNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsResponseValidationError{}
@@ -1,10 +1,11 @@
 package simplev1
 
 import (
-	"fmt"
-	"context"
-	"google.golang.org/protobuf/encoding/protojson"
-	connectgo "github.com/bufbuild/connect-go"
+	context "context"
+	fmt "fmt"
+	connect_go "github.com/bufbuild/connect-go"
+	appsyncjson "github.com/crewlinker/protoc-gen-appsync-go/appsyncjson"
+	v1 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/pagination/v1"
 )
 
 //
@@ -12,16 +13,22 @@ import (
 // ResolveSelectors list all type and field names that are resolved by the protobuf rpc methods. This
 // is usefull to automate hooking up lambda functions to them in AppSync using a tool like AWS CDK.
 var ResolveSelectors = []string{
-	"Query.echo", "Query.echoV2", "Query.latestVersion", "Query.listProfiles",
+	"Query.echo", "Query.echoV2", "Query.listProfiles", "Query.latestVersion", "Query.echoKinds", "Query.notifications", "Query.nextPage",
 }
 
 // SimpleServiceResolver describes the resolver implementation using connect signatures.
 type SimpleServiceResolver interface {
-	Echo(context.Context, *connectgo.Request[EchoRequest]) (*connectgo.Response[EchoResponse], error)
+	Echo(context.Context, *connect_go.Request[EchoRequest]) (*connect_go.Response[EchoResponse], error)
 
-	ListProfiles(context.Context, *connectgo.Request[ListProfilesRequest]) (*connectgo.Response[ListProfilesResponse], error)
+	ListProfiles(context.Context, *connect_go.Request[ListProfilesRequest]) (*connect_go.Response[ListProfilesResponse], error)
 
-	Version(context.Context, *connectgo.Request[VersionRequest]) (*connectgo.Response[VersionResponse], error)
+	Version(context.Context, *connect_go.Request[VersionRequest]) (*connect_go.Response[VersionResponse], error)
+
+	EchoKinds(context.Context, *connect_go.Request[EchoKindsRequest]) (*connect_go.Response[EchoKindsResponse], error)
+
+	ListNotifications(context.Context, *connect_go.Request[ListNotificationsRequest]) (*connect_go.Response[ListNotificationsResponse], error)
+
+	NextPage(context.Context, *connect_go.Request[v1.PageRequest]) (*connect_go.Response[v1.PageInfo], error)
 }
 
 // ResolveSimpleService resolves graphql calls
@@ -31,18 +38,18 @@ func ResolveSimpleService(ctx context.Context, h SimpleServiceResolver, typName,
 
 	case "Query.echo":
 		var in EchoRequest
-		if err := protojson.Unmarshal(args, &in); err != nil {
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
 		}
 
-		req := connectgo.NewRequest(&in)
+		req := connect_go.NewRequest(&in)
 
 		resp, err := h.Echo(ctx, req)
 		if err != nil {
 			return nil, fmt.Errorf("failed to call handler: %w", err)
 		}
 
-		if data, err = protojson.Marshal(resp.Msg); err != nil {
+		if data, err = appsyncjson.Marshal(resp.Msg); err != nil {
 			return nil, fmt.Errorf("failed to marshal output: %w", err)
 		}
 
@@ -50,18 +57,37 @@ func ResolveSimpleService(ctx context.Context, h SimpleServiceResolver, typName,
 
 	case "Query.echoV2":
 		var in EchoRequest
-		if err := protojson.Unmarshal(args, &in); err != nil {
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
 		}
 
-		req := connectgo.NewRequest(&in)
+		req := connect_go.NewRequest(&in)
 
 		resp, err := h.Echo(ctx, req)
 		if err != nil {
 			return nil, fmt.Errorf("failed to call handler: %w", err)
 		}
 
-		if data, err = protojson.Marshal(resp.Msg); err != nil {
+		if data, err = appsyncjson.Marshal(resp.Msg); err != nil {
+			return nil, fmt.Errorf("failed to marshal output: %w", err)
+		}
+
+		return data, nil
+
+	case "Query.listProfiles":
+		var in ListProfilesRequest
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
+		}
+
+		req := connect_go.NewRequest(&in)
+
+		resp, err := h.ListProfiles(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call handler: %w", err)
+		}
+
+		if data, err = appsyncjson.Marshal(resp.Msg); err != nil {
 			return nil, fmt.Errorf("failed to marshal output: %w", err)
 		}
 
@@ -69,37 +95,75 @@ func ResolveSimpleService(ctx context.Context, h SimpleServiceResolver, typName,
 
 	case "Query.latestVersion":
 		var in VersionRequest
-		if err := protojson.Unmarshal(args, &in); err != nil {
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
 		}
 
-		req := connectgo.NewRequest(&in)
+		req := connect_go.NewRequest(&in)
 
 		resp, err := h.Version(ctx, req)
 		if err != nil {
 			return nil, fmt.Errorf("failed to call handler: %w", err)
 		}
 
-		if data, err = protojson.Marshal(resp.Msg); err != nil {
+		if data, err = (appsyncjson.MarshalOptions{}).MarshalField(resp.Msg, "version"); err != nil {
 			return nil, fmt.Errorf("failed to marshal output: %w", err)
 		}
 
 		return data, nil
 
-	case "Query.listProfiles":
-		var in ListProfilesRequest
-		if err := protojson.Unmarshal(args, &in); err != nil {
+	case "Query.echoKinds":
+		var in EchoKindsRequest
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
 		}
 
-		req := connectgo.NewRequest(&in)
+		req := connect_go.NewRequest(&in)
 
-		resp, err := h.ListProfiles(ctx, req)
+		resp, err := h.EchoKinds(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call handler: %w", err)
+		}
+
+		if data, err = appsyncjson.Marshal(resp.Msg); err != nil {
+			return nil, fmt.Errorf("failed to marshal output: %w", err)
+		}
+
+		return data, nil
+
+	case "Query.notifications":
+		var in ListNotificationsRequest
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
+		}
+
+		req := connect_go.NewRequest(&in)
+
+		resp, err := h.ListNotifications(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to call handler: %w", err)
+		}
+
+		if data, err = appsyncjson.Marshal(resp.Msg); err != nil {
+			return nil, fmt.Errorf("failed to marshal output: %w", err)
+		}
+
+		return data, nil
+
+	case "Query.nextPage":
+		var in v1.PageRequest
+		if err := (appsyncjson.UnmarshalOptions{ValidateScalars: true}).Unmarshal(args, &in); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal input: %w", err)
+		}
+
+		req := connect_go.NewRequest(&in)
+
+		resp, err := h.NextPage(ctx, req)
 		if err != nil {
 			return nil, fmt.Errorf("failed to call handler: %w", err)
 		}
 
-		if data, err = protojson.Marshal(resp.Msg); err != nil {
+		if data, err = appsyncjson.Marshal(resp.Msg); err != nil {
 			return nil, fmt.Errorf("failed to marshal output: %w", err)
 		}
 
@@ -8,6 +8,7 @@ import (
 	context "context"
 	errors "errors"
 	connect_go "github.com/bufbuild/connect-go"
+	v11 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/pagination/v1"
 	v1 "github.com/crewlinker/protoc-gen-appsync-go/proto/examples/simple/v1"
 	http "net/http"
 	strings "strings"
@@ -32,7 +33,18 @@ type SimpleServiceClient interface {
 	// ListProfiles
 	ListProfiles(context.Context, *connect_go.Request[v1.ListProfilesRequest]) (*connect_go.Response[v1.ListProfilesResponse], error)
 	// Version resolves to return a scalar string value
+	//
+	// Deprecated: the version is no longer
+	// maintained.
+	//
+	// Deprecated: do not use.
 	Version(context.Context, *connect_go.Request[v1.VersionRequest]) (*connect_go.Response[v1.VersionResponse], error)
+	// EchoKinds returns the scalar kinds it was given
+	EchoKinds(context.Context, *connect_go.Request[v1.EchoKindsRequest]) (*connect_go.Response[v1.EchoKindsResponse], error)
+	// ListNotifications resolves with notifications of different kinds
+	ListNotifications(context.Context, *connect_go.Request[v1.ListNotificationsRequest]) (*connect_go.Response[v1.ListNotificationsResponse], error)
+	// NextPage resolves with messages that are shared from another package
+	NextPage(context.Context, *connect_go.Request[v11.PageRequest]) (*connect_go.Response[v11.PageInfo], error)
 }
 
 // NewSimpleServiceClient constructs a client for the examples.simple.v1.SimpleService service. By
@@ -60,14 +72,32 @@ func NewSimpleServiceClient(httpClient connect_go.HTTPClient, baseURL string, op
 			baseURL+"/examples.simple.v1.SimpleService/Version",
 			opts...,
 		),
+		echoKinds: connect_go.NewClient[v1.EchoKindsRequest, v1.EchoKindsResponse](
+			httpClient,
+			baseURL+"/examples.simple.v1.SimpleService/EchoKinds",
+			opts...,
+		),
+		listNotifications: connect_go.NewClient[v1.ListNotificationsRequest, v1.ListNotificationsResponse](
+			httpClient,
+			baseURL+"/examples.simple.v1.SimpleService/ListNotifications",
+			opts...,
+		),
+		nextPage: connect_go.NewClient[v11.PageRequest, v11.PageInfo](
+			httpClient,
+			baseURL+"/examples.simple.v1.SimpleService/NextPage",
+			opts...,
+		),
 	}
 }
 
 // simpleServiceClient implements SimpleServiceClient.
 type simpleServiceClient struct {
-	echo         *connect_go.Client[v1.EchoRequest, v1.EchoResponse]
-	listProfiles *connect_go.Client[v1.ListProfilesRequest, v1.ListProfilesResponse]
-	version      *connect_go.Client[v1.VersionRequest, v1.VersionResponse]
+	echo              *connect_go.Client[v1.EchoRequest, v1.EchoResponse]
+	listProfiles      *connect_go.Client[v1.ListProfilesRequest, v1.ListProfilesResponse]
+	version           *connect_go.Client[v1.VersionRequest, v1.VersionResponse]
+	echoKinds         *connect_go.Client[v1.EchoKindsRequest, v1.EchoKindsResponse]
+	listNotifications *connect_go.Client[v1.ListNotificationsRequest, v1.ListNotificationsResponse]
+	nextPage          *connect_go.Client[v11.PageRequest, v11.PageInfo]
 }
 
 // Echo calls examples.simple.v1.SimpleService.Echo.
@@ -81,10 +111,27 @@ func (c *simpleServiceClient) ListProfiles(ctx context.Context, req *connect_go.
 }
 
 // Version calls examples.simple.v1.SimpleService.Version.
+//
+// Deprecated: do not use.
 func (c *simpleServiceClient) Version(ctx context.Context, req *connect_go.Request[v1.VersionRequest]) (*connect_go.Response[v1.VersionResponse], error) {
 	return c.version.CallUnary(ctx, req)
 }
 
+// EchoKinds calls examples.simple.v1.SimpleService.EchoKinds.
+func (c *simpleServiceClient) EchoKinds(ctx context.Context, req *connect_go.Request[v1.EchoKindsRequest]) (*connect_go.Response[v1.EchoKindsResponse], error) {
+	return c.echoKinds.CallUnary(ctx, req)
+}
+
+// ListNotifications calls examples.simple.v1.SimpleService.ListNotifications.
+func (c *simpleServiceClient) ListNotifications(ctx context.Context, req *connect_go.Request[v1.ListNotificationsRequest]) (*connect_go.Response[v1.ListNotificationsResponse], error) {
+	return c.listNotifications.CallUnary(ctx, req)
+}
+
+// NextPage calls examples.simple.v1.SimpleService.NextPage.
+func (c *simpleServiceClient) NextPage(ctx context.Context, req *connect_go.Request[v11.PageRequest]) (*connect_go.Response[v11.PageInfo], error) {
+	return c.nextPage.CallUnary(ctx, req)
+}
+
 // SimpleServiceHandler is an implementation of the examples.simple.v1.SimpleService service.
 type SimpleServiceHandler interface {
 	// Echo method returns a string argument
@@ -92,7 +139,18 @@ type SimpleServiceHandler interface {
 	// ListProfiles
 	ListProfiles(context.Context, *connect_go.Request[v1.ListProfilesRequest]) (*connect_go.Response[v1.ListProfilesResponse], error)
 	// Version resolves to return a scalar string value
+	//
+	// Deprecated: the version is no longer
+	// maintained.
+	//
+	// Deprecated: do not use.
 	Version(context.Context, *connect_go.Request[v1.VersionRequest]) (*connect_go.Response[v1.VersionResponse], error)
+	// EchoKinds returns the scalar kinds it was given
+	EchoKinds(context.Context, *connect_go.Request[v1.EchoKindsRequest]) (*connect_go.Response[v1.EchoKindsResponse], error)
+	// ListNotifications resolves with notifications of different kinds
+	ListNotifications(context.Context, *connect_go.Request[v1.ListNotificationsRequest]) (*connect_go.Response[v1.ListNotificationsResponse], error)
+	// NextPage resolves with messages that are shared from another package
+	NextPage(context.Context, *connect_go.Request[v11.PageRequest]) (*connect_go.Response[v11.PageInfo], error)
 }
 
 // NewSimpleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -117,6 +175,21 @@ func NewSimpleServiceHandler(svc SimpleServiceHandler, opts ...connect_go.Handle
 		svc.Version,
 		opts...,
 	))
+	mux.Handle("/examples.simple.v1.SimpleService/EchoKinds", connect_go.NewUnaryHandler(
+		"/examples.simple.v1.SimpleService/EchoKinds",
+		svc.EchoKinds,
+		opts...,
+	))
+	mux.Handle("/examples.simple.v1.SimpleService/ListNotifications", connect_go.NewUnaryHandler(
+		"/examples.simple.v1.SimpleService/ListNotifications",
+		svc.ListNotifications,
+		opts...,
+	))
+	mux.Handle("/examples.simple.v1.SimpleService/NextPage", connect_go.NewUnaryHandler(
+		"/examples.simple.v1.SimpleService/NextPage",
+		svc.NextPage,
+		opts...,
+	))
 	return "/examples.simple.v1.SimpleService/", mux
 }
 
@@ -134,3 +207,15 @@ func (UnimplementedSimpleServiceHandler) ListProfiles(context.Context, *connect_
 func (UnimplementedSimpleServiceHandler) Version(context.Context, *connect_go.Request[v1.VersionRequest]) (*connect_go.Response[v1.VersionResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("examples.simple.v1.SimpleService.Version is not implemented"))
 }
+
+func (UnimplementedSimpleServiceHandler) EchoKinds(context.Context, *connect_go.Request[v1.EchoKindsRequest]) (*connect_go.Response[v1.EchoKindsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("examples.simple.v1.SimpleService.EchoKinds is not implemented"))
+}
+
+func (UnimplementedSimpleServiceHandler) ListNotifications(context.Context, *connect_go.Request[v1.ListNotificationsRequest]) (*connect_go.Response[v1.ListNotificationsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("examples.simple.v1.SimpleService.ListNotifications is not implemented"))
+}
+
+func (UnimplementedSimpleServiceHandler) NextPage(context.Context, *connect_go.Request[v11.PageRequest]) (*connect_go.Response[v11.PageInfo], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("examples.simple.v1.SimpleService.NextPage is not implemented"))
+}